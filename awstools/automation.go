@@ -0,0 +1,134 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Wait for the automation execution to complete
+func (clients AwsClients) waitForAutomationExecution(ctx context.Context, executionId string, timeout int) error {
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		execution, err := clients.GetAutomationExecution(ctx, executionId)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return err
+		}
+
+		switch execution.AutomationExecutionStatus {
+		case "Success", "CompletedWithSuccess":
+			return nil
+		case "Failed", "TimedOut", "Cancelled", "Rejected", "CompletedWithFailure", "Exited":
+			log.Info(ctx, fmt.Sprintf("Automation execution %s %s: %s",
+				executionId, execution.AutomationExecutionStatus, aws.ToString(execution.FailureMessage)))
+
+			return fmt.Errorf("automation execution %s %s", executionId, strings.ToLower(string(execution.AutomationExecutionStatus)))
+		}
+
+		log.Info(ctx, fmt.Sprintf("Automation execution %s status is %s.", executionId, execution.AutomationExecutionStatus))
+	}
+
+	log.Error(ctx, "Automation execution timed out.")
+
+	return errors.New("automation execution timed out")
+}
+
+// Starts SSM automation execution.
+// Waits for the automation execution to complete if requested.
+func (clients AwsClients) RunAutomation(ctx context.Context, input *ssm.StartAutomationExecutionInput, wait bool, executionTimeout int) (ssmtypes.AutomationExecution, error) {
+	output, err := clients.ssmClient.StartAutomationExecution(ctx, input)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return ssmtypes.AutomationExecution{}, err
+	}
+
+	executionId := *output.AutomationExecutionId
+
+	if wait {
+		err = clients.waitForAutomationExecution(ctx, executionId, executionTimeout)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return ssmtypes.AutomationExecution{AutomationExecutionId: &executionId}, err
+		}
+	}
+
+	return clients.GetAutomationExecution(ctx, executionId)
+}
+
+// Retrieves SSM automation execution by Id.
+func (clients AwsClients) GetAutomationExecution(ctx context.Context, executionId string) (ssmtypes.AutomationExecution, error) {
+	output, err := clients.ssmClient.GetAutomationExecution(ctx, &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: &executionId,
+	})
+
+	var notFound *ssmtypes.AutomationExecutionNotFoundException
+	if errors.As(err, &notFound) {
+		return ssmtypes.AutomationExecution{}, nil
+	}
+
+	if err != nil {
+		return ssmtypes.AutomationExecution{}, err
+	}
+
+	return *output.AutomationExecution, nil
+}
+
+// Sends a signal to the running automation execution.
+// Waits for the automation execution to complete if requested.
+func (clients AwsClients) SendAutomationSignal(ctx context.Context, input *ssm.SendAutomationSignalInput, wait bool, executionTimeout int) (ssmtypes.AutomationExecution, error) {
+	_, err := clients.ssmClient.SendAutomationSignal(ctx, input)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return ssmtypes.AutomationExecution{}, err
+	}
+
+	executionId := aws.ToString(input.AutomationExecutionId)
+
+	if wait {
+		err = clients.waitForAutomationExecution(ctx, executionId, executionTimeout)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return ssmtypes.AutomationExecution{AutomationExecutionId: &executionId}, err
+		}
+	}
+
+	return clients.GetAutomationExecution(ctx, executionId)
+}
+
+// Starts SSM Change Manager change request.
+// Waits for the change request to be approved and the runbooks to complete if requested.
+func (clients AwsClients) StartChangeRequest(ctx context.Context, input *ssm.StartChangeRequestExecutionInput, wait bool, executionTimeout int) (ssmtypes.AutomationExecution, error) {
+	output, err := clients.ssmClient.StartChangeRequestExecution(ctx, input)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return ssmtypes.AutomationExecution{}, err
+	}
+
+	executionId := *output.AutomationExecutionId
+
+	if wait {
+		err = clients.waitForAutomationExecution(ctx, executionId, executionTimeout)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return ssmtypes.AutomationExecution{AutomationExecutionId: &executionId}, err
+		}
+	}
+
+	return clients.GetAutomationExecution(ctx, executionId)
+}
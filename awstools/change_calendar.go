@@ -0,0 +1,66 @@
+package awstools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Actions taken when the change calendars are closed
+const (
+	changeCalendarActionFail  string = "fail"
+	changeCalendarActionDefer string = "defer"
+)
+
+// Retrieves the combined state of the change calendars, the state is CLOSED if any calendar is closed.
+func (clients AwsClients) getCalendarState(ctx context.Context, calendarNames []string) (*ssm.GetCalendarStateOutput, error) {
+	return clients.ssmClient.GetCalendarState(ctx, &ssm.GetCalendarStateInput{
+		CalendarNames: calendarNames,
+	})
+}
+
+// Checks the change calendars are open.
+// With defer action, waits until the calendars open or the context is done.
+func (clients AwsClients) checkChangeCalendars(ctx context.Context, calendarNames []string, action string) error {
+	if len(calendarNames) == 0 {
+		return nil
+	}
+
+	for {
+		state, err := clients.getCalendarState(ctx, calendarNames)
+
+		if err != nil {
+			return err
+		}
+
+		if state.State == ssmtypes.CalendarStateOpen {
+			return nil
+		}
+
+		nextTransitionTime := aws.ToString(state.NextTransitionTime)
+
+		if action != changeCalendarActionDefer {
+			return fmt.Errorf("change calendars %s are closed until %s", strings.Join(calendarNames, ", "), nextTransitionTime)
+		}
+
+		log.Info(ctx, fmt.Sprintf("Change calendars are closed until %s.", nextTransitionTime))
+
+		wait := time.Duration(clients.getPollInterval()) * time.Second
+
+		if transition, err := time.Parse(time.RFC3339, nextTransitionTime); err == nil && time.Until(transition) > wait {
+			wait = time.Until(transition)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("change calendars %s are closed: %w", strings.Join(calendarNames, ", "), ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
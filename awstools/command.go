@@ -7,22 +7,29 @@ import (
 	"io"
 	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
 	log "github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // EC2 filter names
 var ec2FilterInstanceId = "instance-id"
 var ec2FilterInstanceStateName = "instance-state-name"
+var ec2FilterTagKey = "tag-key"
 
 // SSM target keys
 var ssmTargetInstanceIds = "InstanceIds"
+var ssmTargetResourceGroupsPrefix = "resource-groups:"
+var ssmTargetTagKey = "tag-key"
 
 var sendTimeout int32 = 600
 
@@ -31,15 +38,80 @@ const sleepTime = 10
 
 const maxLogMsgSize = 65536
 
+// Truncates the output to maxSize bytes at most, without splitting a UTF-8 character.
+func truncateOutput(output string, maxSize int) string {
+	if len(output) <= maxSize {
+		return output
+	}
+
+	end := maxSize
+	for end > 0 && !utf8.RuneStart(output[end]) {
+		end--
+	}
+
+	return output[:end]
+}
+
+// Error of the command invocation which did not succeed
+type CommandInvocationError struct {
+	Status        ssmtypes.CommandInvocationStatus
+	StatusDetails string
+	InstanceId    string
+}
+
+func (e *CommandInvocationError) Error() string {
+	return fmt.Sprintf("command invocation %s on %s instance", strings.ToLower(string(e.Status)), e.InstanceId)
+}
+
+// Error of the command which did not succeed in spite of its max errors
+type CommandError struct {
+	CommandId             string
+	Status                ssmtypes.CommandStatus
+	ErrorCount            int32
+	DeliveryTimedOutCount int32
+}
+
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("command %s %s with %d errors", e.CommandId, strings.ToLower(string(e.Status)), e.ErrorCount)
+}
+
 type AwsClients struct {
-	ec2Client *ec2.Client
-	ssmClient *ssm.Client
-	s3Client  *s3.Client
+	ec2Client            *ec2.Client
+	ssmClient            *ssm.Client
+	s3Client             *s3.Client
+	quickSetupClient     *ssmquicksetup.Client
+	resourceGroupsClient *resourcegroups.Client
+	pollInterval         int
+}
+
+// Returns the interval in seconds between the polls of the waits.
+func (clients AwsClients) getPollInterval() int {
+	if clients.pollInterval > 0 {
+		return clients.pollInterval
+	}
+
+	return sleepTime
+}
+
+// Waits the poll interval before the next poll, without waiting past the deadline.
+// Returns false once the deadline has passed, so that the waits poll at least once, and last at the deadline.
+func (clients AwsClients) waitForNextPoll(deadline time.Time) bool {
+	remaining := time.Until(deadline)
+
+	if remaining <= 0 {
+		return false
+	}
+
+	time.Sleep(min(time.Duration(clients.getPollInterval())*time.Second, remaining))
+
+	return true
 }
 
 // Wait until the target EC2 instances status is online
 func (clients AwsClients) waitForTargetInstances(ctx context.Context, ec2Filters []ec2types.Filter, ssmFilters []ssmtypes.InstanceInformationStringFilter, waitTimeout int) error {
-	for i := 0; i < waitTimeout/sleepTime; i++ {
+	deadline := time.Now().Add(time.Duration(waitTimeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
 		ec2Instances, err := clients.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
 			Filters: ec2Filters,
 		})
@@ -79,8 +151,6 @@ func (clients AwsClients) waitForTargetInstances(ctx context.Context, ec2Filters
 				return nil
 			}
 		}
-
-		time.Sleep(sleepTime * time.Second)
 	}
 
 	log.Error(ctx, "Target instances are not online.")
@@ -90,7 +160,9 @@ func (clients AwsClients) waitForTargetInstances(ctx context.Context, ec2Filters
 
 // Wait for the command invocations to complete
 func (clients AwsClients) waitForCommandInvocations(ctx context.Context, commandId string, timeout *int) error {
-	for i := 0; i < *timeout/sleepTime; i++ {
+	deadline := time.Now().Add(time.Duration(*timeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
 		output, err := clients.ssmClient.ListCommandInvocations(ctx, &ssm.ListCommandInvocationsInput{
 			CommandId: &commandId,
 		})
@@ -101,28 +173,25 @@ func (clients AwsClients) waitForCommandInvocations(ctx context.Context, command
 		}
 
 		if len(output.CommandInvocations) == 0 {
-			time.Sleep(sleepTime * time.Second)
 			continue
 		}
 
 		pendingExecutionsCount := 0
 
 		for _, invocation := range output.CommandInvocations {
-			if invocation.Status == "Pending" || invocation.Status == "InProgress" {
+			if invocation.Status == "Pending" || invocation.Status == "InProgress" || invocation.Status == "Delayed" {
 				pendingExecutionsCount += 1
 			} else if invocation.Status == "Cancelled" || invocation.Status == "TimedOut" || invocation.Status == "Failed" {
 				log.Info(ctx, fmt.Sprintf("Command %s invocation %s on instance %s.",
 					commandId, invocation.Status, *invocation.InstanceId))
 
-				return fmt.Errorf("command invocation %s on %s instance", strings.ToLower(string(invocation.Status)), *invocation.InstanceId)
+				return &CommandInvocationError{Status: invocation.Status, StatusDetails: aws.ToString(invocation.StatusDetails), InstanceId: *invocation.InstanceId}
 			}
 		}
 
 		if pendingExecutionsCount == 0 {
 			return nil
 		}
-
-		time.Sleep(sleepTime * time.Second)
 	}
 
 	log.Error(ctx, "Command invocations timed out.")
@@ -130,33 +199,60 @@ func (clients AwsClients) waitForCommandInvocations(ctx context.Context, command
 	return errors.New("command invocations timed out")
 }
 
-// Retrieves from S3 and prints outputs of the command invocations.
-func (clients AwsClients) printCommandOutput(ctx context.Context, prefix *string, commandId string, s3Bucket *string) error {
-	if s3Bucket == nil || *s3Bucket == "" {
-		log.Info(ctx, "The output S3 bucket is not specified for ssm_command resource.")
-		return nil
+// Wait for the command to complete, tolerating the failed invocations up to the max errors of the command
+func (clients AwsClients) waitForCommand(ctx context.Context, commandId string, timeout *int) error {
+	deadline := time.Now().Add(time.Duration(*timeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		command, err := clients.GetCommand(ctx, commandId)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return err
+		}
+
+		switch command.Status {
+		case ssmtypes.CommandStatusSuccess:
+			return nil
+		case ssmtypes.CommandStatusCancelled, ssmtypes.CommandStatusFailed, ssmtypes.CommandStatusTimedOut:
+			log.Info(ctx, fmt.Sprintf("Command %s %s, %d errors.", commandId, command.Status, command.ErrorCount))
+
+			return &CommandError{CommandId: commandId, Status: command.Status, ErrorCount: command.ErrorCount, DeliveryTimedOutCount: command.DeliveryTimedOutCount}
+		}
 	}
 
+	log.Error(ctx, "Command timed out.")
+
+	return errors.New("command timed out")
+}
+
+// Creates S3 service client with the Region of the bucket.
+func (clients AwsClients) getBucketClient(ctx context.Context, s3Bucket *string) (*s3.Client, error) {
 	location, err := clients.s3Client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
 		Bucket: s3Bucket,
 	})
 
 	if err != nil {
-		log.Error(ctx, err.Error())
-		return err
+		return nil, err
 	}
 
-	// Create S3 service client with a specific Region.
-	cfg, err := config.LoadDefaultConfig(ctx)
-
-	if err != nil {
-		log.Error(ctx, err.Error())
-		return err
+	// The buckets of us-east-1 have no location constraint, the legacy EU constraint is eu-west-1.
+	region := string(location.LocationConstraint)
+	switch location.LocationConstraint {
+	case "":
+		region = "us-east-1"
+	case s3types.BucketLocationConstraintEu:
+		region = "eu-west-1"
 	}
 
-	cfg.Region = string(location.LocationConstraint)
-	s3BucketClient := s3.NewFromConfig(cfg)
+	// The client keeps the credentials of the provider, only its Region changes.
+	return s3.New(clients.s3Client.Options(), func(o *s3.Options) {
+		o.Region = region
+	}), nil
+}
 
+// Lists the keys of the S3 objects of the command outputs.
+func listCommandOutputKeys(ctx context.Context, s3BucketClient *s3.Client, prefix *string, commandId string, s3Bucket *string) ([]string, error) {
 	keyPrefix := commandId
 	if prefix != nil {
 		keyPrefix = *prefix + "/" + commandId
@@ -169,32 +265,74 @@ func (clients AwsClients) printCommandOutput(ctx context.Context, prefix *string
 		Prefix:  &keyPrefix,
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, object := range objects.Contents {
+		keys = append(keys, *object.Key)
+	}
+
+	return keys, nil
+}
+
+// Retrieves from S3 and prints outputs of the command invocations.
+// The outputs are truncated to maxSize bytes when maxSize is positive.
+func (clients AwsClients) printCommandOutput(ctx context.Context, prefix *string, commandId string, s3Bucket *string, maxSize int) error {
+	if s3Bucket == nil || *s3Bucket == "" {
+		log.Info(ctx, "The output S3 bucket is not specified for ssm_command resource.")
+		return nil
+	}
+
+	s3BucketClient, err := clients.getBucketClient(ctx, s3Bucket)
+
 	if err != nil {
 		log.Error(ctx, err.Error())
 		return err
 	}
 
-	if objects.Contents != nil {
-		for _, key := range objects.Contents {
-			object, err := s3BucketClient.GetObject(ctx, &s3.GetObjectInput{
-				Bucket: s3Bucket,
-				Key:    key.Key,
-			})
+	keys, err := listCommandOutputKeys(ctx, s3BucketClient, prefix, commandId, s3Bucket)
 
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return err
+	}
+
+	for _, key := range keys {
+		if maxSize > 0 {
+			msg, truncated, err := readS3Object(ctx, s3BucketClient, *s3Bucket, key, maxSize)
 			if err != nil {
 				log.Error(ctx, err.Error())
-			} else {
-				bytes, err := io.ReadAll(object.Body)
-				if err == nil {
-					log.Info(ctx, fmt.Sprintf("\n*** %s ***", *key.Key))
-					msg := string(bytes)
-					// Slice the message into 64KB pieces.
-					n := len(msg) / maxLogMsgSize
-					for i := 0; i < n; i++ {
-						log.Info(ctx, msg[i*maxLogMsgSize:(i+1)*maxLogMsgSize])
-					}
-					log.Info(ctx, msg[n*maxLogMsgSize:])
+				continue
+			}
+
+			log.Info(ctx, fmt.Sprintf("\n*** %s ***", key))
+			log.Info(ctx, msg)
+			if truncated {
+				log.Info(ctx, fmt.Sprintf("*** %s truncated to %d bytes ***", key, maxSize))
+			}
+			continue
+		}
+
+		object, err := s3BucketClient.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: s3Bucket,
+			Key:    &key,
+		})
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+		} else {
+			bytes, err := io.ReadAll(object.Body)
+			if err == nil {
+				log.Info(ctx, fmt.Sprintf("\n*** %s ***", key))
+				msg := string(bytes)
+				// Slice the message into 64KB pieces.
+				n := len(msg) / maxLogMsgSize
+				for i := 0; i < n; i++ {
+					log.Info(ctx, msg[i*maxLogMsgSize:(i+1)*maxLogMsgSize])
 				}
+				log.Info(ctx, msg[n*maxLogMsgSize:])
 			}
 		}
 	}
@@ -202,41 +340,153 @@ func (clients AwsClients) printCommandOutput(ctx context.Context, prefix *string
 	return nil
 }
 
+// Retrieves from S3 the outputs of the command invocations, combined under the headers of their keys.
+// Only the outputs of the stream, stdout or stderr, are retrieved when the stream is specified.
+func (clients AwsClients) readCommandOutput(ctx context.Context, prefix *string, commandId string, s3Bucket *string, stream string) (string, error) {
+	s3BucketClient, err := clients.getBucketClient(ctx, s3Bucket)
+
+	if err != nil {
+		return "", err
+	}
+
+	keys, err := listCommandOutputKeys(ctx, s3BucketClient, prefix, commandId, s3Bucket)
+
+	if err != nil {
+		return "", err
+	}
+
+	var output strings.Builder
+
+	for _, key := range keys {
+		if stream != "" && !strings.HasSuffix(key, "/"+stream) {
+			continue
+		}
+
+		object, err := s3BucketClient.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: s3Bucket,
+			Key:    &key,
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		bytes, err := io.ReadAll(object.Body)
+		object.Body.Close()
+
+		if err != nil {
+			return "", err
+		}
+
+		output.WriteString(fmt.Sprintf("*** %s ***\n", key))
+		output.Write(bytes)
+		output.WriteString("\n")
+	}
+
+	return output.String(), nil
+}
+
 // Waits until the target EC2 instances status is online.
 // Sends SSM command.
 // Waits for the command invocations to complete.
 // Retrieves from S3 and prints outputs of the command invocations.
 func (clients AwsClients) RunCommand(ctx context.Context, documentName *string, parameters map[string][]string, ssmTargets []ssmtypes.Target, executionTimeout *int, comment *string, s3Bucket *string, s3KeyPrefix *string) (ssmtypes.Command, error) {
+	return clients.runCommand(ctx, &ssm.SendCommandInput{
+		Targets:            ssmTargets,
+		DocumentName:       documentName,
+		Parameters:         parameters,
+		Comment:            comment,
+		TimeoutSeconds:     &sendTimeout,
+		OutputS3BucketName: s3Bucket,
+		OutputS3KeyPrefix:  s3KeyPrefix,
+	}, waitTimeout, executionTimeout, 0, true)
+}
+
+// Runs the SSM command like RunCommand with the given input.
+// Waits up to targetsTimeout seconds for the target instances, not at all when targetsTimeout is 0,
+// and up to executionTimeout seconds for the command invocations.
+// Prints the outputs truncated to maxOutputSize bytes when maxOutputSize is positive.
+// Returns once the command is sent when waitForCompletion is false.
+func (clients AwsClients) runCommand(ctx context.Context, input *ssm.SendCommandInput, targetsTimeout int, executionTimeout *int, maxOutputSize int, waitForCompletion bool) (ssmtypes.Command, error) {
 	var ec2Filters []ec2types.Filter
 	var ssmFilters []ssmtypes.InstanceInformationStringFilter
+	var groupNames []string
+	var groupResourceTypes []string
+
+	for _, target := range input.Targets {
+		// The members of the resource groups are resolved below, once their resource types are known.
+		if strings.HasPrefix(*target.Key, ssmTargetResourceGroupsPrefix) {
+			switch *target.Key {
+			case ssmTargetResourceGroupsName:
+				groupNames = append(groupNames, target.Values...)
+			case ssmTargetResourceGroupsResourceTypeFilters:
+				groupResourceTypes = append(groupResourceTypes, target.Values...)
+			}
+			continue
+		}
 
-	for _, target := range ssmTargets {
 		ec2FilterName := target.Key
-		if strings.EqualFold(*target.Key, ssmTargetInstanceIds) {
+		ssmFilterKey := target.Key
+		switch {
+		case strings.EqualFold(*target.Key, ssmTargetInstanceIds):
 			ec2FilterName = &ec2FilterInstanceId
+		case strings.EqualFold(*target.Key, ssmTargetTagKey):
+			// The values of the tag-key target are the keys of the tags the instances have, whatever their values.
+			ec2FilterName = &ec2FilterTagKey
+			ssmFilterKey = &ssmTargetTagKey
 		}
 
 		ec2Filters = append(ec2Filters, ec2types.Filter{Name: ec2FilterName, Values: target.Values})
-		ssmFilters = append(ssmFilters, ssmtypes.InstanceInformationStringFilter{Key: target.Key, Values: target.Values})
+		ssmFilters = append(ssmFilters, ssmtypes.InstanceInformationStringFilter{Key: ssmFilterKey, Values: target.Values})
+	}
+
+	// The EC2 instances of the resource groups are waited for like the instance Ids targets,
+	// the hybrid managed instances, which are not EC2 instances, are waited for in SSM only.
+	var managedInstanceIds []string
+
+	if targetsTimeout > 0 {
+		for _, groupName := range groupNames {
+			instanceIds, err := clients.listGroupInstanceIds(ctx, groupName, groupResourceTypes)
+			if err != nil {
+				return ssmtypes.Command{}, err
+			}
+
+			var ec2InstanceIds []string
+			for _, instanceId := range instanceIds {
+				if strings.HasPrefix(instanceId, managedInstanceIdPrefix) {
+					managedInstanceIds = append(managedInstanceIds, instanceId)
+				} else {
+					ec2InstanceIds = append(ec2InstanceIds, instanceId)
+				}
+			}
+
+			if len(ec2InstanceIds) > 0 {
+				ec2Filters = append(ec2Filters, ec2types.Filter{Name: &ec2FilterInstanceId, Values: ec2InstanceIds})
+				ssmFilters = append(ssmFilters, ssmtypes.InstanceInformationStringFilter{Key: &ssmTargetInstanceIds, Values: ec2InstanceIds})
+			}
+		}
 	}
 
 	ec2Filters = append(ec2Filters, ec2types.Filter{Name: &ec2FilterInstanceStateName, Values: []string{"pending", "running"}})
 
-	err := clients.waitForTargetInstances(ctx, ec2Filters, ssmFilters, waitTimeout)
-	if err != nil {
-		log.Error(ctx, err.Error())
-		return ssmtypes.Command{}, err
+	deadline := time.Now().Add(time.Duration(targetsTimeout) * time.Second)
+
+	// Without instance Ids, tags or resource groups targets, there are no target instances to wait for.
+	if len(ssmFilters) > 0 && targetsTimeout > 0 {
+		err := clients.waitForTargetInstances(ctx, ec2Filters, ssmFilters, targetsTimeout)
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return ssmtypes.Command{}, err
+		}
 	}
 
-	output, err := clients.ssmClient.SendCommand(ctx, &ssm.SendCommandInput{
-		Targets:            ssmTargets,
-		DocumentName:       documentName,
-		Parameters:         parameters,
-		Comment:            comment,
-		TimeoutSeconds:     &sendTimeout,
-		OutputS3BucketName: s3Bucket,
-		OutputS3KeyPrefix:  s3KeyPrefix,
-	})
+	if len(managedInstanceIds) > 0 {
+		if err := clients.waitForManagedInstances(ctx, managedInstanceIds, deadline); err != nil {
+			return ssmtypes.Command{}, err
+		}
+	}
+
+	output, err := clients.ssmClient.SendCommand(ctx, input)
 
 	if err != nil {
 		log.Error(ctx, err.Error())
@@ -245,13 +495,39 @@ func (clients AwsClients) RunCommand(ctx context.Context, documentName *string,
 
 	commandId := *output.Command.CommandId
 
-	err = clients.waitForCommandInvocations(ctx, commandId, executionTimeout)
+	if !waitForCompletion {
+		log.Info(ctx, fmt.Sprintf("Command %s sent, its completion is not waited for.", commandId))
+		return clients.GetCommand(ctx, commandId)
+	}
+
+	// With max errors, the command succeeds in spite of the failed invocations below the threshold.
+	if input.MaxErrors != nil {
+		err = clients.waitForCommand(ctx, commandId, executionTimeout)
+	} else {
+		err = clients.waitForCommandInvocations(ctx, commandId, executionTimeout)
+	}
 
-	clients.printCommandOutput(ctx, s3KeyPrefix, commandId, s3Bucket)
+	clients.printCommandOutput(ctx, input.OutputS3KeyPrefix, commandId, input.OutputS3BucketName, maxOutputSize)
+
+	// SSM cancels the command when one of its alarms is triggered.
+	if err != nil && input.AlarmConfiguration != nil {
+		if command, getErr := clients.GetCommand(ctx, commandId); getErr == nil && len(command.TriggeredAlarms) > 0 {
+			var alarmNames []string
+			for _, alarm := range command.TriggeredAlarms {
+				alarmNames = append(alarmNames, aws.ToString(alarm.Name))
+			}
+
+			err = fmt.Errorf("%w, triggered alarms: %s", err, strings.Join(alarmNames, ", "))
+		}
+	}
 
 	if err != nil {
 		log.Error(ctx, err.Error())
-		return ssmtypes.Command{}, err
+
+		// The command is returned with the error, so that its Id and status can be recorded.
+		command, _ := clients.GetCommand(ctx, commandId)
+
+		return command, err
 	}
 
 	return clients.GetCommand(ctx, commandId)
@@ -273,3 +549,105 @@ func (clients AwsClients) GetCommand(ctx context.Context, commandId string) (ssm
 
 	return commands.Commands[0], nil
 }
+
+// Retrieves Ids of the instances the command was sent to.
+func (clients AwsClients) listCommandInstanceIds(ctx context.Context, commandId string) ([]string, error) {
+	invocations, err := clients.listCommandInvocations(ctx, commandId, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var instanceIds []string
+	for _, invocation := range invocations {
+		instanceIds = append(instanceIds, *invocation.InstanceId)
+	}
+
+	return instanceIds, nil
+}
+
+// Retrieves all the invocations of the command, with the command plugins details when requested.
+func (clients AwsClients) listCommandInvocations(ctx context.Context, commandId string, details bool) ([]ssmtypes.CommandInvocation, error) {
+	var invocations []ssmtypes.CommandInvocation
+
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: &commandId,
+		Details:   details,
+	}
+
+	for {
+		output, err := clients.ssmClient.ListCommandInvocations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		invocations = append(invocations, output.CommandInvocations...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return invocations, nil
+}
+
+// Retrieves the outputs of the command invocations by instance Id.
+// SSM truncates the outputs of the command plugins returned by the API.
+func (clients AwsClients) listCommandOutputs(ctx context.Context, commandId string) (map[string]string, error) {
+	invocations, err := clients.listCommandInvocations(ctx, commandId, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]string)
+	for _, invocation := range invocations {
+		var pluginOutputs []string
+		for _, plugin := range invocation.CommandPlugins {
+			pluginOutputs = append(pluginOutputs, aws.ToString(plugin.Output))
+		}
+		outputs[*invocation.InstanceId] = strings.Join(pluginOutputs, "\n")
+	}
+
+	return outputs, nil
+}
+
+// Retrieves the statuses of the command invocations by instance Id.
+func (clients AwsClients) listCommandInvocationStatuses(ctx context.Context, commandId string) (map[string]string, error) {
+	invocations, err := clients.listCommandInvocations(ctx, commandId, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string)
+	for _, invocation := range invocations {
+		statuses[*invocation.InstanceId] = string(invocation.Status)
+	}
+
+	return statuses, nil
+}
+
+// Retrieves the time the last command plugin finished on the target instances.
+// Returns zero time when no plugin has finished yet.
+func (clients AwsClients) getCommandCompletedTime(ctx context.Context, commandId string) (time.Time, error) {
+	invocations, err := clients.listCommandInvocations(ctx, commandId, true)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var completedTime time.Time
+	for _, invocation := range invocations {
+		for _, plugin := range invocation.CommandPlugins {
+			if plugin.ResponseFinishDateTime != nil && plugin.ResponseFinishDateTime.After(completedTime) {
+				completedTime = *plugin.ResponseFinishDateTime
+			}
+		}
+	}
+
+	return completedTime, nil
+}
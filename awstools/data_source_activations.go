@@ -0,0 +1,172 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_activations data source
+const (
+	attActivations string = "activations"
+	attExhausted   string = "exhausted"
+	attCreatedDate string = "created_date"
+)
+
+func dataSourceActivationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.DescribeActivationsInput{}
+
+	arguments := map[string]ssmtypes.DescribeActivationsFilterKeys{
+		attDefaultInstanceName: ssmtypes.DescribeActivationsFilterKeysDefaultInstanceName,
+		attIamRole:             ssmtypes.DescribeActivationsFilterKeysIamRole,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			input.Filters = append(input.Filters, ssmtypes.DescribeActivationsFilter{
+				FilterKey:    arguments[key],
+				FilterValues: []string{v.(string)},
+			})
+		}
+	}
+
+	var ids []string
+	var activations []interface{}
+
+	for {
+		output, err := awsClients.ssmClient.DescribeActivations(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, activation := range output.ActivationList {
+			ids = append(ids, aws.ToString(activation.ActivationId))
+
+			activations = append(activations, map[string]interface{}{
+				attActivationId:        aws.ToString(activation.ActivationId),
+				attDescription:         aws.ToString(activation.Description),
+				attDefaultInstanceName: aws.ToString(activation.DefaultInstanceName),
+				attIamRole:             aws.ToString(activation.IamRole),
+				attRegistrationLimit:   int(aws.ToInt32(activation.RegistrationLimit)),
+				attRegistrationsCount:  int(aws.ToInt32(activation.RegistrationsCount)),
+				attExhausted:           aws.ToInt32(activation.RegistrationsCount) >= aws.ToInt32(activation.RegistrationLimit),
+				attExpirationDate:      formatTime(activation.ExpirationDate),
+				attExpired:             activation.Expired,
+				attCreatedDate:         formatTime(activation.CreatedDate),
+				attTags:                flattenTags(activation.Tags),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attIds:         ids,
+		attActivations: activations,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceActivations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceActivationsRead,
+		Schema: map[string]*schema.Schema{
+			attDefaultInstanceName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attIamRole: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attActivations: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attActivationId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDefaultInstanceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attIamRole: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attRegistrationLimit: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attRegistrationsCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attExhausted: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						attExpirationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attExpired: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						attCreatedDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTags: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
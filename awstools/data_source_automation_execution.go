@@ -0,0 +1,186 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_automation_execution data source
+const (
+	attExecutedBy     string = "executed_by"
+	attStepExecutions string = "step_executions"
+)
+
+func flattenStepExecutions(steps []ssmtypes.StepExecution) []interface{} {
+	var blocks []interface{}
+
+	for _, step := range steps {
+		blocks = append(blocks, map[string]interface{}{
+			attStepName:           aws.ToString(step.StepName),
+			attStepExecutionId:    aws.ToString(step.StepExecutionId),
+			attAction:             aws.ToString(step.Action),
+			attStatus:             string(step.StepStatus),
+			attFailureMessage:     aws.ToString(step.FailureMessage),
+			attExecutionStartTime: formatTime(step.ExecutionStartTime),
+			attExecutionEndTime:   formatTime(step.ExecutionEndTime),
+			attOutputs:            flattenAutomationOutputs(step.Outputs),
+		})
+	}
+
+	return blocks
+}
+
+func dataSourceAutomationExecutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionId := d.Get(attExecutionId).(string)
+
+	execution, err := awsClients.GetAutomationExecution(ctx, executionId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if execution.AutomationExecutionId == nil {
+		return diag.Errorf("automation execution %s not found", executionId)
+	}
+
+	d.SetId(executionId)
+
+	values := map[string]interface{}{
+		attDocumentName:       execution.DocumentName,
+		attDocumentVersion:    execution.DocumentVersion,
+		attMode:               execution.Mode,
+		attExecutedBy:         execution.ExecutedBy,
+		attStatus:             execution.AutomationExecutionStatus,
+		attFailureMessage:     execution.FailureMessage,
+		attExecutionStartTime: formatTime(execution.ExecutionStartTime),
+		attExecutionEndTime:   formatTime(execution.ExecutionEndTime),
+		attParameters:         flattenAutomationOutputs(execution.Parameters),
+		attOutputs:            flattenAutomationOutputs(execution.Outputs),
+		attStepExecutions:     flattenStepExecutions(execution.StepExecutions),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceAutomationExecution() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAutomationExecutionRead,
+		Schema: map[string]*schema.Schema{
+			attExecutionId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attMode: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutedBy: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attFailureMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutionStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutionEndTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attParameters: automationValuesSchema(),
+			attOutputs:    automationValuesSchema(),
+			attStepExecutions: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attStepName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStepExecutionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attFailureMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attExecutionStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attExecutionEndTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOutputs: automationValuesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema of the computed name and values blocks of automation parameters and outputs.
+func automationValuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				attValues: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
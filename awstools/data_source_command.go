@@ -0,0 +1,194 @@
+package awstools
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_command data source
+const (
+	attInstanceIds           string = "instance_ids"
+	attStatusDetails         string = "status_details"
+	attCompletedTime         string = "completed_time"
+	attTargetCount           string = "target_count"
+	attCompletedCount        string = "completed_count"
+	attErrorCount            string = "error_count"
+	attDeliveryTimedOutCount string = "delivery_timed_out_count"
+)
+
+func dataSourceCommandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	commandId := d.Get(attCommandId).(string)
+
+	command, err := awsClients.GetCommand(ctx, commandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		return diag.Errorf("command %s not found", commandId)
+	}
+
+	completedTime, err := awsClients.getCommandCompletedTime(ctx, commandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(commandId)
+
+	values := map[string]interface{}{
+		attDocumentName:          command.DocumentName,
+		attDocumentVersion:       command.DocumentVersion,
+		attComment:               command.Comment,
+		attParameters:            flattenParameters(d, attParameters, command.Parameters),
+		attTargets:               flattenTargets(command.Targets),
+		attInstanceIds:           command.InstanceIds,
+		attStatus:                command.Status,
+		attStatusDetails:         command.StatusDetails,
+		attRequestedTime:         command.RequestedDateTime.UTC().Format(time.RFC3339),
+		attExecutionTimeout:      command.TimeoutSeconds,
+		attMaxConcurrency:        command.MaxConcurrency,
+		attMaxErrors:             command.MaxErrors,
+		attTargetCount:           command.TargetCount,
+		attCompletedCount:        command.CompletedCount,
+		attErrorCount:            command.ErrorCount,
+		attDeliveryTimedOutCount: command.DeliveryTimedOutCount,
+		attCompletedTime:         "",
+	}
+
+	if !completedTime.IsZero() {
+		values[attCompletedTime] = completedTime.UTC().Format(time.RFC3339)
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceCommand() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCommandRead,
+		Schema: map[string]*schema.Schema{
+			attCommandId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatusDetails: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attCompletedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attTargetCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attCompletedCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attErrorCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attDeliveryTimedOutCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
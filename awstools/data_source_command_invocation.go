@@ -0,0 +1,154 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_command_invocation data source
+const (
+	attPluginName            string = "plugin_name"
+	attStandardOutputContent string = "standard_output_content"
+	attStandardOutputUrl     string = "standard_output_url"
+	attStandardErrorContent  string = "standard_error_content"
+	attStandardErrorUrl      string = "standard_error_url"
+	attResponseCode          string = "response_code"
+	attExecutionStartTime    string = "execution_start_time"
+	attExecutionEndTime      string = "execution_end_time"
+	attExecutionElapsedTime  string = "execution_elapsed_time"
+)
+
+func dataSourceCommandInvocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	commandId := d.Get(attCommandId).(string)
+	instanceId := d.Get(attInstanceId).(string)
+
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  &commandId,
+		InstanceId: &instanceId,
+	}
+
+	if v, ok := d.GetOk(attPluginName); ok {
+		input.PluginName = aws.String(v.(string))
+	}
+
+	invocation, err := awsClients.ssmClient.GetCommandInvocation(ctx, input)
+
+	if err != nil {
+		var notFound *ssmtypes.InvocationDoesNotExist
+		if errors.As(err, &notFound) {
+			return diag.Errorf("invocation of command %s on instance %s not found", commandId, instanceId)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(commandId + "/" + instanceId)
+
+	values := map[string]interface{}{
+		attDocumentName:          invocation.DocumentName,
+		attDocumentVersion:       invocation.DocumentVersion,
+		attComment:               invocation.Comment,
+		attStatus:                invocation.Status,
+		attStatusDetails:         invocation.StatusDetails,
+		attStandardOutputContent: invocation.StandardOutputContent,
+		attStandardOutputUrl:     invocation.StandardOutputUrl,
+		attStandardErrorContent:  invocation.StandardErrorContent,
+		attStandardErrorUrl:      invocation.StandardErrorUrl,
+		attResponseCode:          invocation.ResponseCode,
+		attExecutionStartTime:    invocation.ExecutionStartDateTime,
+		attExecutionEndTime:      invocation.ExecutionEndDateTime,
+		attExecutionElapsedTime:  invocation.ExecutionElapsedTime,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceCommandInvocation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCommandInvocationRead,
+		Schema: map[string]*schema.Schema{
+			attCommandId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attPluginName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatusDetails: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStandardOutputContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStandardOutputUrl: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStandardErrorContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStandardErrorUrl: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attResponseCode: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attExecutionStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutionEndTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attExecutionElapsedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_command_invocations data source
+const (
+	attInvocations  string = "invocations"
+	attInstanceName string = "instance_name"
+	attTraceOutput  string = "trace_output"
+	attPlugins      string = "plugins"
+	attOutput       string = "output"
+)
+
+func flattenCommandPlugins(plugins []ssmtypes.CommandPlugin) []interface{} {
+	var blocks []interface{}
+
+	for _, plugin := range plugins {
+		blocks = append(blocks, map[string]interface{}{
+			attName:               aws.ToString(plugin.Name),
+			attStatus:             string(plugin.Status),
+			attStatusDetails:      aws.ToString(plugin.StatusDetails),
+			attResponseCode:       int(plugin.ResponseCode),
+			attOutput:             aws.ToString(plugin.Output),
+			attStandardOutputUrl:  aws.ToString(plugin.StandardOutputUrl),
+			attStandardErrorUrl:   aws.ToString(plugin.StandardErrorUrl),
+			attExecutionStartTime: formatTime(plugin.ResponseStartDateTime),
+			attExecutionEndTime:   formatTime(plugin.ResponseFinishDateTime),
+		})
+	}
+
+	return blocks
+}
+
+func dataSourceCommandInvocationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	commandId := d.Get(attCommandId).(string)
+	status := d.Get(attStatus).(string)
+
+	invocations, err := awsClients.listCommandInvocations(ctx, commandId, true)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var instanceIds []string
+	var blocks []interface{}
+
+	for _, invocation := range invocations {
+		if status != "" && string(invocation.Status) != status {
+			continue
+		}
+
+		// The response code of the invocation is the one of its last plugin which ran.
+		responseCode := -1
+		for _, plugin := range invocation.CommandPlugins {
+			if plugin.ResponseFinishDateTime != nil {
+				responseCode = int(plugin.ResponseCode)
+			}
+		}
+
+		instanceIds = append(instanceIds, aws.ToString(invocation.InstanceId))
+
+		blocks = append(blocks, map[string]interface{}{
+			attInstanceId:        aws.ToString(invocation.InstanceId),
+			attInstanceName:      aws.ToString(invocation.InstanceName),
+			attStatus:            string(invocation.Status),
+			attStatusDetails:     aws.ToString(invocation.StatusDetails),
+			attResponseCode:      responseCode,
+			attTraceOutput:       aws.ToString(invocation.TraceOutput),
+			attStandardOutputUrl: aws.ToString(invocation.StandardOutputUrl),
+			attStandardErrorUrl:  aws.ToString(invocation.StandardErrorUrl),
+			attRequestedTime:     formatTime(invocation.RequestedDateTime),
+			attPlugins:           flattenCommandPlugins(invocation.CommandPlugins),
+		})
+	}
+
+	d.SetId(commandId)
+
+	values := map[string]interface{}{
+		attInstanceIds: instanceIds,
+		attInvocations: blocks,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceCommandInvocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCommandInvocationsRead,
+		Schema: map[string]*schema.Schema{
+			attCommandId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.CommandInvocationStatus("").Values()), false),
+			},
+			attInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInvocations: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attInstanceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatusDetails: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attResponseCode: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attTraceOutput: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStandardOutputUrl: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStandardErrorUrl: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attRequestedTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPlugins: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStatusDetails: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attResponseCode: {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									attOutput: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStandardOutputUrl: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStandardErrorUrl: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attExecutionStartTime: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attExecutionEndTime: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package awstools
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_command_query data source
+const (
+	attCommands string = "commands"
+)
+
+// Retrieves the standard outputs of the command invocations by instance Id.
+// SSM truncates the standard output returned by the API to 24000 characters.
+func (clients AwsClients) listCommandStandardOutputs(ctx context.Context, commandId string) (map[string]string, error) {
+	invocations, err := clients.listCommandInvocations(ctx, commandId, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]string)
+	for _, invocation := range invocations {
+		output, err := clients.ssmClient.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+			CommandId:  &commandId,
+			InstanceId: invocation.InstanceId,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[*invocation.InstanceId] = aws.ToString(output.StandardOutputContent)
+	}
+
+	return outputs, nil
+}
+
+func dataSourceCommandQueryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	documentName := getScriptDocumentName(d.Get(attInterpreter).(string))
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {strings.Join(getStringList(d, attCommands), "\n")},
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, nil, nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outputs, err := awsClients.listCommandStandardOutputs(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceIds := make([]string, 0, len(outputs))
+	for instanceId := range outputs {
+		instanceIds = append(instanceIds, instanceId)
+	}
+
+	sort.Strings(instanceIds)
+
+	d.SetId(*command.CommandId)
+
+	values := map[string]interface{}{
+		attCommandId:   command.CommandId,
+		attInstanceIds: instanceIds,
+		attOutputs:     outputs,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceCommandQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCommandQueryRead,
+		Schema: map[string]*schema.Schema{
+			attCommands: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInterpreter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      interpreterShell,
+				ValidateFunc: validation.StringInSlice([]string{interpreterShell, interpreterPowerShell}, false),
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attCommandId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attOutputs: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
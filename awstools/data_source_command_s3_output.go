@@ -0,0 +1,201 @@
+package awstools
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_command_s3_output data source
+const (
+	attMaxSize   string = "max_size"
+	attTruncated string = "truncated"
+	attKeys      string = "keys"
+)
+
+// Names of the S3 objects of the command plugin outputs
+var s3OutputStdout = "stdout"
+var s3OutputStderr = "stderr"
+
+// Retrieves the content of the S3 object, at most maxSize bytes.
+// Returns whether the content was truncated.
+func readS3Object(ctx context.Context, client *s3.Client, bucket string, key string, maxSize int) (string, bool, error) {
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+
+	if err != nil {
+		return "", false, err
+	}
+
+	defer object.Body.Close()
+
+	bytes, err := io.ReadAll(io.LimitReader(object.Body, int64(maxSize)+1))
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(bytes) > maxSize {
+		return truncateOutput(string(bytes), maxSize), true, nil
+	}
+
+	return string(bytes), false, nil
+}
+
+func dataSourceCommandS3OutputRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	commandId := d.Get(attCommandId).(string)
+	bucket := d.Get(attS3BucketName).(string)
+	maxSize := d.Get(attMaxSize).(int)
+
+	keyPrefix := commandId
+	if v, ok := d.GetOk(attS3KeyPrefix); ok {
+		keyPrefix = strings.TrimSuffix(v.(string), "/") + "/" + commandId
+	}
+	if v, ok := d.GetOk(attInstanceId); ok {
+		keyPrefix += "/" + v.(string)
+	}
+
+	s3BucketClient, err := awsClients.getBucketClient(ctx, &bucket)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: &bucket,
+		Prefix: aws.String(keyPrefix + "/"),
+	}
+
+	var keys []string
+
+	for {
+		output, err := s3BucketClient.ListObjectsV2(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, object := range output.Contents {
+			key := aws.ToString(object.Key)
+			if strings.HasSuffix(key, "/"+s3OutputStdout) || strings.HasSuffix(key, "/"+s3OutputStderr) {
+				keys = append(keys, key)
+			}
+		}
+
+		if output.NextContinuationToken == nil {
+			break
+		}
+
+		input.ContinuationToken = output.NextContinuationToken
+	}
+
+	// Outputs are concatenated in the key order, up to max_size bytes each.
+	contents := map[string]*strings.Builder{
+		s3OutputStdout: {},
+		s3OutputStderr: {},
+	}
+	truncated := false
+
+	for _, key := range keys {
+		content := contents[s3OutputStdout]
+		if strings.HasSuffix(key, "/"+s3OutputStderr) {
+			content = contents[s3OutputStderr]
+		}
+
+		remaining := maxSize - content.Len()
+		if remaining <= 0 {
+			truncated = true
+			continue
+		}
+
+		value, objectTruncated, err := readS3Object(ctx, s3BucketClient, bucket, key, remaining)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		content.WriteString(value)
+		truncated = truncated || objectTruncated
+	}
+
+	d.SetId(bucket + "/" + keyPrefix)
+
+	values := map[string]interface{}{
+		attKeys:                  keys,
+		attStandardOutputContent: contents[s3OutputStdout].String(),
+		attStandardErrorContent:  contents[s3OutputStderr].String(),
+		attTruncated:             truncated,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceCommandS3Output() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCommandS3OutputRead,
+		Schema: map[string]*schema.Schema{
+			attCommandId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attMaxSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1048576,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			attKeys: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attStandardOutputContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStandardErrorContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attTruncated: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
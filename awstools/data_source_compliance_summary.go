@@ -0,0 +1,207 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_compliance_summary data source
+const (
+	attSummaries               string = "summaries"
+	attCompliantCount          string = "compliant_count"
+	attNonCompliantCount       string = "non_compliant_count"
+	attNonCompliantResourceIds string = "non_compliant_resource_ids"
+)
+
+// Compliance counts of a compliance type.
+type complianceSummary struct {
+	compliantCount          int
+	nonCompliantResourceIds []string
+}
+
+// Retrieves the compliance summaries of the resources.
+func (clients AwsClients) listResourceComplianceSummaries(ctx context.Context, filters []ssmtypes.ComplianceStringFilter) ([]ssmtypes.ResourceComplianceSummaryItem, error) {
+	var items []ssmtypes.ResourceComplianceSummaryItem
+
+	input := &ssm.ListResourceComplianceSummariesInput{
+		Filters: filters,
+	}
+
+	for {
+		output, err := clients.ssmClient.ListResourceComplianceSummaries(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, output.ResourceComplianceSummaryItems...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return items, nil
+}
+
+func dataSourceComplianceSummaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	var filters []ssmtypes.ComplianceStringFilter
+
+	complianceType := d.Get(attComplianceType).(string)
+	if complianceType != "" {
+		filters = append(filters, ssmtypes.ComplianceStringFilter{
+			Key:    &ssmComplianceFilterComplianceType,
+			Values: []string{complianceType},
+			Type:   ssmtypes.ComplianceQueryOperatorTypeEqual,
+		})
+	}
+
+	items, err := awsClients.listResourceComplianceSummaries(ctx, filters)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Compliance summaries cannot be filtered by tags, the managed instances with the tags are looked up instead.
+	var instanceIds map[string]bool
+	tags := d.Get(attTags).(map[string]interface{})
+
+	if len(tags) > 0 {
+		var instanceFilters []ssmtypes.InstanceInformationStringFilter
+		for _, key := range sortedKeys(tags) {
+			instanceFilters = append(instanceFilters, ssmtypes.InstanceInformationStringFilter{Key: aws.String("tag:" + key), Values: []string{tags[key].(string)}})
+		}
+
+		instances, err := awsClients.listInstances(ctx, instanceFilters)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		instanceIds = make(map[string]bool)
+		for _, instance := range instances {
+			instanceIds[aws.ToString(instance.InstanceId)] = true
+		}
+	}
+
+	summaries := make(map[string]*complianceSummary)
+
+	for _, item := range items {
+		resourceId := aws.ToString(item.ResourceId)
+
+		if instanceIds != nil && !instanceIds[resourceId] {
+			continue
+		}
+
+		itemType := aws.ToString(item.ComplianceType)
+		if summaries[itemType] == nil {
+			summaries[itemType] = &complianceSummary{}
+		}
+
+		if item.Status == ssmtypes.ComplianceStatusCompliant {
+			summaries[itemType].compliantCount += 1
+		} else {
+			summaries[itemType].nonCompliantResourceIds = append(summaries[itemType].nonCompliantResourceIds, resourceId)
+		}
+	}
+
+	var flattenedSummaries []interface{}
+	compliantCount := 0
+	nonCompliantCount := 0
+
+	for _, itemType := range sortedKeys(summaries) {
+		summary := summaries[itemType]
+		compliantCount += summary.compliantCount
+		nonCompliantCount += len(summary.nonCompliantResourceIds)
+
+		flattenedSummaries = append(flattenedSummaries, map[string]interface{}{
+			attComplianceType:          itemType,
+			attCompliantCount:          summary.compliantCount,
+			attNonCompliantCount:       len(summary.nonCompliantResourceIds),
+			attNonCompliantResourceIds: summary.nonCompliantResourceIds,
+		})
+	}
+
+	id := []string{complianceType}
+	for _, key := range sortedKeys(tags) {
+		id = append(id, key+"="+tags[key].(string))
+	}
+	d.SetId(getContentSha256([]byte(strings.Join(id, ","))))
+
+	values := map[string]interface{}{
+		attSummaries:         flattenedSummaries,
+		attCompliantCount:    compliantCount,
+		attNonCompliantCount: nonCompliantCount,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceComplianceSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComplianceSummaryRead,
+		Schema: map[string]*schema.Schema{
+			attComplianceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTags: tagsSchema(),
+			attCompliantCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attNonCompliantCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attSummaries: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attComplianceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attCompliantCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attNonCompliantCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attNonCompliantResourceIds: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
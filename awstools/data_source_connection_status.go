@@ -0,0 +1,69 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_connection_status data source
+const (
+	attConnected string = "connected"
+)
+
+func dataSourceConnectionStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	target := d.Get(attTarget).(string)
+
+	output, err := awsClients.ssmClient.GetConnectionStatus(ctx, &ssm.GetConnectionStatusInput{
+		Target: &target,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(target)
+
+	values := map[string]interface{}{
+		attStatus:    output.Status,
+		attConnected: output.Status == ssmtypes.ConnectionStatusConnected,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceConnectionStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionStatusRead,
+		Schema: map[string]*schema.Schema{
+			attTarget: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attConnected: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
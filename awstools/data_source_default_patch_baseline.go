@@ -0,0 +1,105 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_default_patch_baseline data source
+const (
+	attAwsManaged string = "aws_managed"
+)
+
+func dataSourceDefaultPatchBaselineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	operatingSystem := d.Get(attOperatingSystem).(string)
+
+	output, err := awsClients.ssmClient.GetDefaultPatchBaseline(ctx, &ssm.GetDefaultPatchBaselineInput{
+		OperatingSystem: ssmtypes.OperatingSystem(operatingSystem),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The predefined baselines of AWS are identified by their ARN.
+	baselineId := aws.ToString(output.BaselineId)
+	awsManaged := strings.HasPrefix(baselineId, "arn:")
+
+	arn := ""
+	if awsManaged {
+		arn = baselineId
+	}
+
+	baseline, err := awsClients.ssmClient.GetPatchBaseline(ctx, &ssm.GetPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(operatingSystem)
+
+	values := map[string]interface{}{
+		attBaselineId:  baselineId,
+		attArn:         arn,
+		attName:        baseline.Name,
+		attDescription: baseline.Description,
+		attAwsManaged:  awsManaged,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceDefaultPatchBaseline() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDefaultPatchBaselineRead,
+		Schema: map[string]*schema.Schema{
+			attOperatingSystem: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OperatingSystem("").Values()), false),
+			},
+			attBaselineId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attAwsManaged: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,210 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_document data source
+const (
+	attDefaultValue  string = "default_value"
+	attPlatformTypes string = "platform_types"
+)
+
+func flattenDocumentParameters(parameters []ssmtypes.DocumentParameter) []interface{} {
+	var result []interface{}
+
+	for _, parameter := range parameters {
+		result = append(result, map[string]interface{}{
+			attName:         aws.ToString(parameter.Name),
+			attType:         string(parameter.Type),
+			attDescription:  aws.ToString(parameter.Description),
+			attDefaultValue: aws.ToString(parameter.DefaultValue),
+		})
+	}
+
+	return result
+}
+
+func dataSourceDocumentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	describeInput := &ssm.DescribeDocumentInput{
+		Name: &name,
+	}
+
+	getInput := &ssm.GetDocumentInput{
+		Name: &name,
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		describeInput.DocumentVersion = aws.String(v.(string))
+		getInput.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDocumentFormat); ok {
+		getInput.DocumentFormat = ssmtypes.DocumentFormat(v.(string))
+	}
+
+	description, err := awsClients.ssmClient.DescribeDocument(ctx, describeInput)
+
+	var notFound *ssmtypes.InvalidDocument
+	if errors.As(err, &notFound) {
+		return diag.Errorf("document %s not found", name)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, getInput)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	document := description.Document
+
+	d.SetId(name)
+
+	values := map[string]interface{}{
+		attContent:         output.Content,
+		attDocumentFormat:  output.DocumentFormat,
+		attDocumentType:    document.DocumentType,
+		attDocumentVersion: document.DocumentVersion,
+		attVersionName:     document.VersionName,
+		attLatestVersion:   document.LatestVersion,
+		attDefaultVersion:  document.DefaultVersion,
+		attDescription:     document.Description,
+		attOwner:           document.Owner,
+		attStatus:          document.Status,
+		attSchemaVersion:   document.SchemaVersion,
+		attTargetType:      document.TargetType,
+		attPlatformTypes:   enumValues(document.PlatformTypes),
+		attParameters:      flattenDocumentParameters(document.Parameters),
+		attTags:            flattenTags(document.Tags),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDocumentRead,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attDocumentFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.DocumentFormat("").Values()), false),
+			},
+			attContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDocumentType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attVersionName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLatestVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDefaultVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attSchemaVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attTargetType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformTypes: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDefaultValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			attTags: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,316 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_document_content data source
+const (
+	attSteps  string = "steps"
+	attInputs string = "inputs"
+)
+
+// Matches the parameter references of document content, e.g. {{ commands }}.
+var documentParameterRegexp = regexache.MustCompile(`\{\{\s*([\w.-]+)\s*\}\}`)
+
+// Resolves the values of the document parameters from the given values and the parameter defaults.
+// StringList values are lists, the values of other non String types are decoded from JSON when possible.
+func resolveDocumentParameters(declared map[string]interface{}, parameters map[string][]string) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{})
+
+	for _, name := range sortedKeys(parameters) {
+		if _, ok := declared[name]; !ok {
+			return nil, fmt.Errorf("parameter %s is not declared in the document", name)
+		}
+	}
+
+	for _, name := range sortedKeys(declared) {
+		definition, _ := declared[name].(map[string]interface{})
+		parameterType, _ := definition["type"].(string)
+
+		values, ok := parameters[name]
+		if !ok {
+			defaultValue, ok := definition["default"]
+			if !ok {
+				return nil, fmt.Errorf("parameter %s has no value and no default value", name)
+			}
+			resolved[name] = defaultValue
+			continue
+		}
+
+		switch parameterType {
+		case string(ssmtypes.DocumentParameterTypeStringList):
+			list := make([]interface{}, 0, len(values))
+			for _, value := range values {
+				list = append(list, value)
+			}
+			resolved[name] = list
+		case string(ssmtypes.DocumentParameterTypeString), "":
+			resolved[name] = strings.Join(values, ",")
+		default:
+			value := strings.Join(values, ",")
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+				resolved[name] = decoded
+			} else {
+				resolved[name] = value
+			}
+		}
+	}
+
+	return resolved, nil
+}
+
+// Formats the parameter value referenced inside a longer string.
+func formatDocumentParameter(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, formatDocumentParameter(item))
+		}
+		return strings.Join(values, ",")
+	default:
+		bytes, _ := json.Marshal(v)
+		return string(bytes)
+	}
+}
+
+// Substitutes the parameter references in the document content.
+// A string which is a single reference is replaced by the parameter value, list values are spliced into arrays.
+// References which are not document parameters, e.g. step outputs or Parameter Store references, are kept.
+func renderDocumentContent(content interface{}, parameters map[string]interface{}) interface{} {
+	switch v := content.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			rendered[key] = renderDocumentContent(value, parameters)
+		}
+		return rendered
+	case []interface{}:
+		rendered := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			value := renderDocumentContent(item, parameters)
+			if list, ok := value.([]interface{}); ok {
+				if _, ok := item.(string); ok {
+					rendered = append(rendered, list...)
+					continue
+				}
+			}
+			rendered = append(rendered, value)
+		}
+		return rendered
+	case string:
+		if match := documentParameterRegexp.FindStringSubmatch(v); match != nil && match[0] == strings.TrimSpace(v) {
+			if value, ok := parameters[match[1]]; ok {
+				return value
+			}
+		}
+		return documentParameterRegexp.ReplaceAllStringFunc(v, func(reference string) string {
+			name := documentParameterRegexp.FindStringSubmatch(reference)[1]
+			if value, ok := parameters[name]; ok {
+				return formatDocumentParameter(value)
+			}
+			return reference
+		})
+	default:
+		return v
+	}
+}
+
+// Lists the steps of the rendered document, mainSteps or runtimeConfig plugins of schema 1.2 documents.
+func flattenDocumentSteps(document map[string]interface{}) ([]interface{}, error) {
+	var steps []interface{}
+
+	if mainSteps, ok := document["mainSteps"].([]interface{}); ok {
+		for _, s := range mainSteps {
+			step, _ := s.(map[string]interface{})
+			name, _ := step["name"].(string)
+			action, _ := step["action"].(string)
+
+			inputs, err := json.Marshal(step["inputs"])
+
+			if err != nil {
+				return nil, err
+			}
+
+			steps = append(steps, map[string]interface{}{
+				attName:   name,
+				attAction: action,
+				attInputs: string(inputs),
+			})
+		}
+
+		return steps, nil
+	}
+
+	if runtimeConfig, ok := document["runtimeConfig"].(map[string]interface{}); ok {
+		for _, plugin := range sortedKeys(runtimeConfig) {
+			inputs, err := json.Marshal(runtimeConfig[plugin])
+
+			if err != nil {
+				return nil, err
+			}
+
+			steps = append(steps, map[string]interface{}{
+				attName:   plugin,
+				attAction: plugin,
+				attInputs: string(inputs),
+			})
+		}
+	}
+
+	return steps, nil
+}
+
+func dataSourceDocumentContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	input := &ssm.GetDocumentInput{
+		Name:           &name,
+		DocumentFormat: ssmtypes.DocumentFormatJson,
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, input)
+
+	var notFound *ssmtypes.InvalidDocument
+	if errors.As(err, &notFound) {
+		return diag.Errorf("document %s not found", name)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(aws.ToString(output.Content)), &document); err != nil {
+		return diag.FromErr(err)
+	}
+
+	declared, _ := document["parameters"].(map[string]interface{})
+
+	parameters, err := resolveDocumentParameters(declared, getParameters(d, attParameters))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The parameters section is kept as is, only their references are substituted.
+	rendered := renderDocumentContent(document, parameters).(map[string]interface{})
+	if declared != nil {
+		rendered["parameters"] = declared
+	}
+
+	content, err := json.Marshal(rendered)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	steps, err := flattenDocumentSteps(rendered)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getContentSha256(content))
+
+	values := map[string]interface{}{
+		attDocumentVersion: output.DocumentVersion,
+		attContent:         string(content),
+		attSteps:           steps,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceDocumentContent() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDocumentContentRead,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attSteps: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attInputs: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
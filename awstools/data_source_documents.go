@@ -0,0 +1,210 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_documents data source
+const (
+	attNamePrefix string = "name_prefix"
+	attArns       string = "arns"
+	attDocuments  string = "documents"
+)
+
+// SSM document filter keys
+const (
+	ssmDocumentFilterOwner         = "Owner"
+	ssmDocumentFilterDocumentType  = "DocumentType"
+	ssmDocumentFilterName          = "Name"
+	ssmDocumentFilterPlatformTypes = "PlatformTypes"
+)
+
+func getDocumentFilters(d *schema.ResourceData) []ssmtypes.DocumentKeyValuesFilter {
+	var filters []ssmtypes.DocumentKeyValuesFilter
+
+	arguments := map[string]string{
+		attOwner:        ssmDocumentFilterOwner,
+		attDocumentType: ssmDocumentFilterDocumentType,
+		attNamePrefix:   ssmDocumentFilterName,
+		attPlatformType: ssmDocumentFilterPlatformTypes,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			filters = append(filters, ssmtypes.DocumentKeyValuesFilter{Key: aws.String(arguments[key]), Values: []string{v.(string)}})
+		}
+	}
+
+	tags := d.Get(attTags).(map[string]interface{})
+	for _, key := range sortedKeys(tags) {
+		filters = append(filters, ssmtypes.DocumentKeyValuesFilter{Key: aws.String("tag:" + key), Values: []string{tags[key].(string)}})
+	}
+
+	return filters
+}
+
+func dataSourceDocumentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	documents, err := awsClients.listDocuments(ctx, getDocumentFilters(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namePrefix := d.Get(attNamePrefix).(string)
+
+	names := make([]string, 0, len(documents))
+	arns := make([]string, 0, len(documents))
+	var flattenedDocuments []interface{}
+
+	for _, document := range documents {
+		name := aws.ToString(document.Name)
+
+		// Name filter of SSM matches the documents by keyword, not by prefix.
+		if !strings.HasPrefix(name, namePrefix) {
+			continue
+		}
+
+		arn := awsClients.documentArn(aws.ToString(document.Owner), name)
+
+		names = append(names, name)
+		arns = append(arns, arn)
+
+		flattenedDocuments = append(flattenedDocuments, map[string]interface{}{
+			attName:            name,
+			attArn:             arn,
+			attOwner:           aws.ToString(document.Owner),
+			attDocumentType:    string(document.DocumentType),
+			attDocumentFormat:  string(document.DocumentFormat),
+			attDocumentVersion: aws.ToString(document.DocumentVersion),
+			attVersionName:     aws.ToString(document.VersionName),
+			attTargetType:      aws.ToString(document.TargetType),
+			attPlatformTypes:   enumValues(document.PlatformTypes),
+			attTags:            flattenTags(document.Tags),
+		})
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(arns, ","))))
+
+	values := map[string]interface{}{
+		attNames:     names,
+		attArns:      arns,
+		attDocuments: flattenedDocuments,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceDocuments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDocumentsRead,
+		Schema: map[string]*schema.Schema{
+			attOwner: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDocumentType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.DocumentType("").Values()), false),
+			},
+			attNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attPlatformType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PlatformType("").Values()), false),
+			},
+			attTags: tagsSchema(),
+			attNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attArns: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attDocuments: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attArn: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOwner: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDocumentType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDocumentFormat: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDocumentVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attVersionName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTargetType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPlatformTypes: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attTags: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,166 @@
+package awstools
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_fleet_summary data source
+const (
+	attTotalCount          string = "total_count"
+	attOnlineCount         string = "online_count"
+	attConnectionLostCount string = "connection_lost_count"
+	attInactiveCount       string = "inactive_count"
+	attOutdatedAgentCount  string = "outdated_agent_count"
+	attPlatformTypeCounts  string = "platform_type_counts"
+	attPlatformNameCounts  string = "platform_name_counts"
+	attAgentVersionCounts  string = "agent_version_counts"
+)
+
+func dataSourceFleetSummaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instances, err := awsClients.listInstances(ctx, getInstanceFilters(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pingStatusCounts := make(map[ssmtypes.PingStatus]int)
+	platformTypeCounts := make(map[string]int)
+	platformNameCounts := make(map[string]int)
+	agentVersionCounts := make(map[string]int)
+	outdatedAgentCount := 0
+	ids := make([]string, 0, len(instances))
+
+	for _, instance := range instances {
+		ids = append(ids, aws.ToString(instance.InstanceId))
+
+		pingStatusCounts[instance.PingStatus]++
+		platformTypeCounts[string(instance.PlatformType)]++
+		platformNameCounts[aws.ToString(instance.PlatformName)]++
+		agentVersionCounts[aws.ToString(instance.AgentVersion)]++
+
+		if !aws.ToBool(instance.IsLatestVersion) {
+			outdatedAgentCount++
+		}
+	}
+
+	sort.Strings(ids)
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attTotalCount:          len(instances),
+		attOnlineCount:         pingStatusCounts[ssmtypes.PingStatusOnline],
+		attConnectionLostCount: pingStatusCounts[ssmtypes.PingStatusConnectionLost],
+		attInactiveCount:       pingStatusCounts[ssmtypes.PingStatusInactive],
+		attOutdatedAgentCount:  outdatedAgentCount,
+		attPlatformTypeCounts:  platformTypeCounts,
+		attPlatformNameCounts:  platformNameCounts,
+		attAgentVersionCounts:  agentVersionCounts,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceFleetSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFleetSummaryRead,
+		Schema: map[string]*schema.Schema{
+			attFilter: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+			attPlatformType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PlatformType("").Values()), false),
+			},
+			attPingStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PingStatus("").Values()), false),
+			},
+			attAgentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTotalCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attOnlineCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attConnectionLostCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInactiveCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attOutdatedAgentCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attPlatformTypeCounts: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			attPlatformNameCounts: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			attAgentVersionCounts: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
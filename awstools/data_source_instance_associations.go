@@ -0,0 +1,255 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_instance_associations data source
+const (
+	attAssociations            string = "associations"
+	attAssociationIds          string = "association_ids"
+	attUnhealthyAssociationIds string = "unhealthy_association_ids"
+	attAssociationVersion      string = "association_version"
+	attDetailedStatus          string = "detailed_status"
+	attExecutionDate           string = "execution_date"
+	attExecutionSummary        string = "execution_summary"
+	attErrorCode               string = "error_code"
+	attHealthy                 string = "healthy"
+	attOutputUrl               string = "output_url"
+)
+
+// Status of the association which has not run on the instance yet
+const associationStatusPending = "Pending"
+
+// Status of the association which ran successfully on the instance
+const associationStatusSuccess = "Success"
+
+// Retrieves the associations which apply to the instance.
+func (clients AwsClients) listEffectiveInstanceAssociations(ctx context.Context, instanceId string) ([]ssmtypes.InstanceAssociation, error) {
+	var associations []ssmtypes.InstanceAssociation
+
+	input := &ssm.DescribeEffectiveInstanceAssociationsInput{
+		InstanceId: &instanceId,
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribeEffectiveInstanceAssociations(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		associations = append(associations, output.Associations...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return associations, nil
+}
+
+// Retrieves the status of the last execution of the associations on the instance.
+func (clients AwsClients) listInstanceAssociationStatuses(ctx context.Context, instanceId string) ([]ssmtypes.InstanceAssociationStatusInfo, error) {
+	var statuses []ssmtypes.InstanceAssociationStatusInfo
+
+	input := &ssm.DescribeInstanceAssociationsStatusInput{
+		InstanceId: &instanceId,
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribeInstanceAssociationsStatus(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, output.InstanceAssociationStatusInfos...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return statuses, nil
+}
+
+func dataSourceInstanceAssociationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Get(attInstanceId).(string)
+
+	associations, err := awsClients.listEffectiveInstanceAssociations(ctx, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statuses, err := awsClients.listInstanceAssociationStatuses(ctx, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusesById := make(map[string]ssmtypes.InstanceAssociationStatusInfo)
+	for _, status := range statuses {
+		statusesById[aws.ToString(status.AssociationId)] = status
+	}
+
+	// The associations which have not run on the instance yet have no status.
+	for _, association := range associations {
+		associationId := aws.ToString(association.AssociationId)
+		if _, ok := statusesById[associationId]; !ok {
+			statusesById[associationId] = ssmtypes.InstanceAssociationStatusInfo{
+				AssociationId:      association.AssociationId,
+				AssociationVersion: association.AssociationVersion,
+				Status:             aws.String(associationStatusPending),
+			}
+		}
+	}
+
+	associationIds := sortedKeys(statusesById)
+	var unhealthyAssociationIds []string
+	var blocks []interface{}
+
+	for _, associationId := range associationIds {
+		status := statusesById[associationId]
+
+		if aws.ToString(status.Status) != associationStatusSuccess {
+			unhealthyAssociationIds = append(unhealthyAssociationIds, associationId)
+		}
+
+		outputUrl := ""
+		if status.OutputUrl != nil && status.OutputUrl.S3OutputUrl != nil {
+			outputUrl = aws.ToString(status.OutputUrl.S3OutputUrl.OutputUrl)
+		}
+
+		blocks = append(blocks, map[string]interface{}{
+			attAssociationId:      associationId,
+			attAssociationName:    aws.ToString(status.AssociationName),
+			attAssociationVersion: aws.ToString(status.AssociationVersion),
+			attName:               aws.ToString(status.Name),
+			attDocumentVersion:    aws.ToString(status.DocumentVersion),
+			attStatus:             aws.ToString(status.Status),
+			attDetailedStatus:     aws.ToString(status.DetailedStatus),
+			attExecutionDate:      formatTime(status.ExecutionDate),
+			attExecutionSummary:   aws.ToString(status.ExecutionSummary),
+			attErrorCode:          aws.ToString(status.ErrorCode),
+			attOutputUrl:          outputUrl,
+		})
+	}
+
+	d.SetId(instanceId)
+
+	values := map[string]interface{}{
+		attAssociationIds:          associationIds,
+		attUnhealthyAssociationIds: unhealthyAssociationIds,
+		attHealthy:                 len(unhealthyAssociationIds) == 0,
+		attAssociations:            blocks,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInstanceAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceAssociationsRead,
+		Schema: map[string]*schema.Schema{
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attAssociationIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attUnhealthyAssociationIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attHealthy: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			attAssociations: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attAssociationId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAssociationName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAssociationVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDocumentVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDetailedStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attExecutionDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attExecutionSummary: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attErrorCode: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOutputUrl: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_instance_information data source
+const (
+	attIsLatestVersion               string = "is_latest_version"
+	attLastPingTime                  string = "last_ping_time"
+	attAssociationStatus             string = "association_status"
+	attLastAssociationTime           string = "last_association_time"
+	attLastSuccessfulAssociationTime string = "last_successful_association_time"
+)
+
+func dataSourceInstanceInformationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Get(attInstanceId).(string)
+
+	instance, err := awsClients.GetInstanceInformation(ctx, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if instance.InstanceId == nil {
+		return diag.Errorf("managed instance %s not found", instanceId)
+	}
+
+	d.SetId(instanceId)
+
+	values := map[string]interface{}{
+		attName:                          instance.Name,
+		attResourceType:                  instance.ResourceType,
+		attPingStatus:                    instance.PingStatus,
+		attLastPingTime:                  formatTime(instance.LastPingDateTime),
+		attAgentVersion:                  instance.AgentVersion,
+		attIsLatestVersion:               aws.ToBool(instance.IsLatestVersion),
+		attPlatformType:                  instance.PlatformType,
+		attPlatformName:                  instance.PlatformName,
+		attPlatformVersion:               instance.PlatformVersion,
+		attComputerName:                  instance.ComputerName,
+		attIpAddress:                     instance.IPAddress,
+		attIamRole:                       instance.IamRole,
+		attActivationId:                  instance.ActivationId,
+		attRegistrationDate:              formatTime(instance.RegistrationDate),
+		attAssociationStatus:             instance.AssociationStatus,
+		attLastAssociationTime:           formatTime(instance.LastAssociationExecutionDate),
+		attLastSuccessfulAssociationTime: formatTime(instance.LastSuccessfulAssociationExecutionDate),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInstanceInformation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceInformationRead,
+		Schema: map[string]*schema.Schema{
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attResourceType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPingStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastPingTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attAgentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attIsLatestVersion: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			attPlatformType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attComputerName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attIpAddress: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attIamRole: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attActivationId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRegistrationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attAssociationStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastAssociationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastSuccessfulAssociationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
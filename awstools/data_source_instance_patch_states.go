@@ -0,0 +1,199 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_instance_patch_states data source
+const (
+	attPatchStates                 string = "patch_states"
+	attOperation                   string = "operation"
+	attOperationStartTime          string = "operation_start_time"
+	attInstalledPendingRebootCount string = "installed_pending_reboot_count"
+	attInstalledRejectedCount      string = "installed_rejected_count"
+	attCriticalNonCompliantCount   string = "critical_non_compliant_count"
+	attSecurityNonCompliantCount   string = "security_non_compliant_count"
+)
+
+func dataSourceInstancePatchStatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	var patchStates []ssmtypes.InstancePatchState
+	var err error
+	var id string
+
+	if patchGroup, ok := d.GetOk(attPatchGroup); ok {
+		id = patchGroup.(string)
+		patchStates, err = awsClients.describeInstancePatchStatesForPatchGroup(ctx, id)
+	} else {
+		instanceIds := getStringList(d, attInstanceIds)
+		id = getContentSha256([]byte(strings.Join(instanceIds, ",")))
+		patchStates, err = awsClients.describeInstancePatchStates(ctx, instanceIds)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var flattenedStates []interface{}
+	missingCount := 0
+	failedCount := 0
+	installedPendingRebootCount := 0
+
+	for _, state := range patchStates {
+		missingCount += int(state.MissingCount)
+		failedCount += int(state.FailedCount)
+		installedPendingRebootCount += int(aws.ToInt32(state.InstalledPendingRebootCount))
+
+		flattenedStates = append(flattenedStates, map[string]interface{}{
+			attInstanceId:                  aws.ToString(state.InstanceId),
+			attPatchGroup:                  aws.ToString(state.PatchGroup),
+			attBaselineId:                  aws.ToString(state.BaselineId),
+			attOperation:                   string(state.Operation),
+			attRebootOption:                string(state.RebootOption),
+			attInstalledCount:              int(state.InstalledCount),
+			attInstalledOtherCount:         int(state.InstalledOtherCount),
+			attInstalledPendingRebootCount: int(aws.ToInt32(state.InstalledPendingRebootCount)),
+			attInstalledRejectedCount:      int(aws.ToInt32(state.InstalledRejectedCount)),
+			attMissingCount:                int(state.MissingCount),
+			attFailedCount:                 int(state.FailedCount),
+			attNotApplicableCount:          int(state.NotApplicableCount),
+			attCriticalNonCompliantCount:   int(aws.ToInt32(state.CriticalNonCompliantCount)),
+			attSecurityNonCompliantCount:   int(aws.ToInt32(state.SecurityNonCompliantCount)),
+			attOperationStartTime:          formatTime(state.OperationStartTime),
+			attOperationEndTime:            formatTime(state.OperationEndTime),
+		})
+	}
+
+	d.SetId(id)
+
+	values := map[string]interface{}{
+		attPatchStates:                 flattenedStates,
+		attMissingCount:                missingCount,
+		attFailedCount:                 failedCount,
+		attInstalledPendingRebootCount: installedPendingRebootCount,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInstancePatchStates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstancePatchStatesRead,
+		Schema: map[string]*schema.Schema{
+			attInstanceIds: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{attInstanceIds, attPatchGroup},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attPatchGroup: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attMissingCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attFailedCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInstalledPendingRebootCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attPatchStates: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPatchGroup: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attBaselineId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOperation: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attRebootOption: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attInstalledCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attInstalledOtherCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attInstalledPendingRebootCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attInstalledRejectedCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attMissingCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attFailedCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attNotApplicableCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attCriticalNonCompliantCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attSecurityNonCompliantCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attOperationStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOperationEndTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package awstools
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_instances data source
+const (
+	attFilter      string = "filter"
+	attIds         string = "ids"
+	attIpAddresses string = "ip_addresses"
+	attInstances   string = "instances"
+)
+
+func getInstanceFilters(d *schema.ResourceData) []ssmtypes.InstanceInformationStringFilter {
+	var filters []ssmtypes.InstanceInformationStringFilter
+
+	for _, f := range d.Get(attFilter).([]interface{}) {
+		filter := f.(map[string]interface{})
+		var values []string
+		for _, value := range filter[attValues].([]interface{}) {
+			values = append(values, value.(string))
+		}
+		filters = append(filters, ssmtypes.InstanceInformationStringFilter{Key: aws.String(filter[attKey].(string)), Values: values})
+	}
+
+	tags := d.Get(attTags).(map[string]interface{})
+	for _, key := range sortedKeys(tags) {
+		filters = append(filters, ssmtypes.InstanceInformationStringFilter{Key: aws.String("tag:" + key), Values: []string{tags[key].(string)}})
+	}
+
+	arguments := map[string]string{
+		attPlatformType: ssmInstanceFilterPlatformTypes,
+		attPingStatus:   ssmInstanceFilterPingStatus,
+		attAgentVersion: ssmInstanceFilterAgentVersion,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			filters = append(filters, ssmtypes.InstanceInformationStringFilter{Key: aws.String(arguments[key]), Values: []string{v.(string)}})
+		}
+	}
+
+	return filters
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instances, err := awsClients.listInstances(ctx, getInstanceFilters(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slices.SortFunc(instances, func(a, b ssmtypes.InstanceInformation) int {
+		return strings.Compare(aws.ToString(a.InstanceId), aws.ToString(b.InstanceId))
+	})
+
+	ids := make([]string, 0, len(instances))
+	ipAddresses := make([]string, 0, len(instances))
+	var flattenedInstances []interface{}
+
+	for _, instance := range instances {
+		ids = append(ids, aws.ToString(instance.InstanceId))
+
+		if instance.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *instance.IPAddress)
+		}
+
+		flattenedInstances = append(flattenedInstances, map[string]interface{}{
+			attInstanceId:      aws.ToString(instance.InstanceId),
+			attName:            aws.ToString(instance.Name),
+			attPingStatus:      string(instance.PingStatus),
+			attResourceType:    string(instance.ResourceType),
+			attPlatformType:    string(instance.PlatformType),
+			attPlatformName:    aws.ToString(instance.PlatformName),
+			attPlatformVersion: aws.ToString(instance.PlatformVersion),
+			attAgentVersion:    aws.ToString(instance.AgentVersion),
+			attComputerName:    aws.ToString(instance.ComputerName),
+			attIpAddress:       aws.ToString(instance.IPAddress),
+		})
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attIds:         ids,
+		attIpAddresses: ipAddresses,
+		attInstances:   flattenedInstances,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstancesRead,
+		Schema: map[string]*schema.Schema{
+			attFilter: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+			attPlatformType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PlatformType("").Values()), false),
+			},
+			attPingStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PingStatus("").Values()), false),
+			},
+			attAgentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attIpAddresses: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInstances: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPingStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPlatformType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPlatformName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPlatformVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAgentVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attComputerName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attIpAddress: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_inventory data source
+const (
+	attAggregator   string = "aggregator"
+	attExpression   string = "expression"
+	attEntitiesJson string = "entities_json"
+)
+
+func getInventoryFilters(d *schema.ResourceData) []ssmtypes.InventoryFilter {
+	var filters []ssmtypes.InventoryFilter
+
+	for _, f := range d.Get(attFilter).([]interface{}) {
+		block := f.(map[string]interface{})
+		filter := ssmtypes.InventoryFilter{
+			Key:  aws.String(block[attKey].(string)),
+			Type: ssmtypes.InventoryQueryOperatorType(block[attType].(string)),
+		}
+		for _, value := range block[attValues].([]interface{}) {
+			filter.Values = append(filter.Values, value.(string))
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+// Retrieves the inventory entities matching the query.
+func (clients AwsClients) getInventory(ctx context.Context, input *ssm.GetInventoryInput) ([]ssmtypes.InventoryResultEntity, error) {
+	var entities []ssmtypes.InventoryResultEntity
+
+	for {
+		output, err := clients.ssmClient.GetInventory(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entities = append(entities, output.Entities...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return entities, nil
+}
+
+func dataSourceInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.GetInventoryInput{
+		Filters: getInventoryFilters(d),
+	}
+
+	if v, ok := d.GetOk(attTypeName); ok {
+		input.ResultAttributes = []ssmtypes.ResultAttribute{
+			{
+				TypeName: aws.String(v.(string)),
+			},
+		}
+	}
+
+	for _, expression := range getStringList(d, attAggregator) {
+		input.Aggregators = append(input.Aggregators, ssmtypes.InventoryAggregator{
+			Expression: aws.String(expression),
+		})
+	}
+
+	entities, err := awsClients.getInventory(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Entities are exposed as the content rows by type name by entity Id.
+	result := make(map[string]map[string][]map[string]string)
+	ids := make([]string, 0, len(entities))
+
+	for _, entity := range entities {
+		id := aws.ToString(entity.Id)
+		ids = append(ids, id)
+
+		result[id] = make(map[string][]map[string]string)
+		for typeName, item := range entity.Data {
+			result[id][typeName] = item.Content
+		}
+	}
+
+	sort.Strings(ids)
+
+	bytes, err := json.Marshal(result)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attInstanceIds:  ids,
+		attEntitiesJson: string(bytes),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInventoryRead,
+		Schema: map[string]*schema.Schema{
+			attTypeName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attFilter: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(ssmtypes.InventoryQueryOperatorTypeEqual),
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.InventoryQueryOperatorType("").Values()), false),
+						},
+					},
+				},
+			},
+			attAggregator: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attEntitiesJson: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_inventory_schema data source
+const (
+	attSubType     string = "sub_type"
+	attTypeNames   string = "type_names"
+	attSchemas     string = "schemas"
+	attDisplayName string = "display_name"
+	attAttributes  string = "attributes"
+)
+
+// Retrieves the schemas of the inventory types.
+func (clients AwsClients) getInventorySchemas(ctx context.Context, input *ssm.GetInventorySchemaInput) ([]ssmtypes.InventoryItemSchema, error) {
+	var schemas []ssmtypes.InventoryItemSchema
+
+	for {
+		output, err := clients.ssmClient.GetInventorySchema(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schemas = append(schemas, output.Schemas...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return schemas, nil
+}
+
+func dataSourceInventorySchemaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.GetInventorySchemaInput{
+		Aggregator: d.Get(attAggregator).(bool),
+		SubType:    aws.Bool(d.Get(attSubType).(bool)),
+	}
+
+	typeName := d.Get(attTypeName).(string)
+	if typeName != "" {
+		input.TypeName = &typeName
+	}
+
+	schemas, err := awsClients.getInventorySchemas(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	typeNames := make([]string, 0, len(schemas))
+	var flattenedSchemas []interface{}
+
+	for _, itemSchema := range schemas {
+		typeNames = append(typeNames, aws.ToString(itemSchema.TypeName))
+
+		var attributes []interface{}
+		for _, attribute := range itemSchema.Attributes {
+			attributes = append(attributes, map[string]interface{}{
+				attName:     aws.ToString(attribute.Name),
+				attDataType: string(attribute.DataType),
+			})
+		}
+
+		flattenedSchemas = append(flattenedSchemas, map[string]interface{}{
+			attTypeName:    aws.ToString(itemSchema.TypeName),
+			attVersion:     aws.ToString(itemSchema.Version),
+			attDisplayName: aws.ToString(itemSchema.DisplayName),
+			attAttributes:  attributes,
+		})
+	}
+
+	if typeName == "" {
+		typeName = "all"
+	}
+
+	d.SetId(typeName)
+
+	values := map[string]interface{}{
+		attTypeNames: typeNames,
+		attSchemas:   flattenedSchemas,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceInventorySchema() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInventorySchemaRead,
+		Schema: map[string]*schema.Schema{
+			attTypeName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attAggregator: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attSubType: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTypeNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attSchemas: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attTypeName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDisplayName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attAttributes: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attDataType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
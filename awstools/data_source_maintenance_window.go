@@ -0,0 +1,224 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_maintenance_window data source
+const (
+	attNextExecutionTime string = "next_execution_time"
+)
+
+// SSM maintenance window filter keys
+var ssmMaintenanceWindowFilterName = "Name"
+
+// Finds Id of the maintenance window matching the name and the tags.
+// Maintenance windows cannot be filtered by tags, the tags of each window matching the name are compared.
+func (clients AwsClients) findMaintenanceWindowId(ctx context.Context, name string, tags map[string]interface{}) (string, error) {
+	input := &ssm.DescribeMaintenanceWindowsInput{}
+
+	if name != "" {
+		input.Filters = []ssmtypes.MaintenanceWindowFilter{
+			{
+				Key:    &ssmMaintenanceWindowFilterName,
+				Values: []string{name},
+			},
+		}
+	}
+
+	var windowIds []string
+
+	for {
+		output, err := clients.ssmClient.DescribeMaintenanceWindows(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, window := range output.WindowIdentities {
+			windowId := aws.ToString(window.WindowId)
+
+			if len(tags) > 0 {
+				windowTags, err := clients.listTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId)
+
+				if err != nil {
+					return "", err
+				}
+
+				matches := true
+				for key, value := range tags {
+					if v, ok := windowTags[key]; !ok || v != value.(string) {
+						matches = false
+						break
+					}
+				}
+
+				if !matches {
+					continue
+				}
+			}
+
+			windowIds = append(windowIds, windowId)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	switch len(windowIds) {
+	case 0:
+		return "", errors.New("no maintenance window matches the arguments")
+	case 1:
+		return windowIds[0], nil
+	default:
+		return "", fmt.Errorf("%d maintenance windows match the arguments, use more specific arguments", len(windowIds))
+	}
+}
+
+func dataSourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Get(attWindowId).(string)
+
+	if windowId == "" {
+		var err error
+		windowId, err = awsClients.findMaintenanceWindowId(ctx, d.Get(attName).(string), d.Get(attTags).(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	window, err := awsClients.ssmClient.GetMaintenanceWindow(ctx, &ssm.GetMaintenanceWindowInput{
+		WindowId: &windowId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		return diag.Errorf("maintenance window %s not found", windowId)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(windowId)
+
+	values := map[string]interface{}{
+		attWindowId:                 windowId,
+		attName:                     window.Name,
+		attDescription:              window.Description,
+		attSchedule:                 window.Schedule,
+		attScheduleTimezone:         window.ScheduleTimezone,
+		attScheduleOffset:           window.ScheduleOffset,
+		attDuration:                 window.Duration,
+		attCutoff:                   window.Cutoff,
+		attAllowUnassociatedTargets: window.AllowUnassociatedTargets,
+		attEnabled:                  window.Enabled,
+		attStartDate:                window.StartDate,
+		attEndDate:                  window.EndDate,
+		attNextExecutionTime:        window.NextExecutionTime,
+		attTags:                     tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMaintenanceWindowRead,
+		Schema: map[string]*schema.Schema{
+			attWindowId: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{attWindowId, attName, attTags},
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attSchedule: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attScheduleTimezone: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attScheduleOffset: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attDuration: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attCutoff: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attAllowUnassociatedTargets: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			attEnabled: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			attStartDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attEndDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attNextExecutionTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
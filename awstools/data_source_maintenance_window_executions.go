@@ -0,0 +1,254 @@
+package awstools
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_maintenance_window_executions data source
+const (
+	attExecutedAfter     string = "executed_after"
+	attExecutedBefore    string = "executed_before"
+	attExecutions        string = "executions"
+	attWindowExecutionId string = "window_execution_id"
+	attTaskExecutionId   string = "task_execution_id"
+	attTasks             string = "tasks"
+	attStartTime         string = "start_time"
+	attEndTime           string = "end_time"
+)
+
+// SSM maintenance window execution filter keys
+const (
+	ssmWindowExecutionFilterExecutedAfter  = "ExecutedAfter"
+	ssmWindowExecutionFilterExecutedBefore = "ExecutedBefore"
+)
+
+// Retrieves the executions of the maintenance window, the most recent first.
+func (clients AwsClients) listMaintenanceWindowExecutions(ctx context.Context, input *ssm.DescribeMaintenanceWindowExecutionsInput) ([]ssmtypes.MaintenanceWindowExecution, error) {
+	var executions []ssmtypes.MaintenanceWindowExecution
+
+	for {
+		output, err := clients.ssmClient.DescribeMaintenanceWindowExecutions(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		executions = append(executions, output.WindowExecutions...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return executions, nil
+}
+
+// Retrieves the task executions of the maintenance window execution.
+func (clients AwsClients) listMaintenanceWindowExecutionTasks(ctx context.Context, windowExecutionId string) ([]ssmtypes.MaintenanceWindowExecutionTaskIdentity, error) {
+	var tasks []ssmtypes.MaintenanceWindowExecutionTaskIdentity
+
+	input := &ssm.DescribeMaintenanceWindowExecutionTasksInput{
+		WindowExecutionId: &windowExecutionId,
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribeMaintenanceWindowExecutionTasks(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, output.WindowExecutionTaskIdentities...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return tasks, nil
+}
+
+func dataSourceMaintenanceWindowExecutionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Get(attWindowId).(string)
+
+	input := &ssm.DescribeMaintenanceWindowExecutionsInput{
+		WindowId: &windowId,
+	}
+
+	arguments := map[string]string{
+		attExecutedAfter:  ssmWindowExecutionFilterExecutedAfter,
+		attExecutedBefore: ssmWindowExecutionFilterExecutedBefore,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			executedTime, _ := time.Parse(time.RFC3339, v.(string))
+			input.Filters = append(input.Filters, ssmtypes.MaintenanceWindowFilter{
+				Key:    aws.String(arguments[key]),
+				Values: []string{executedTime.UTC().Format(time.RFC3339)},
+			})
+		}
+	}
+
+	executions, err := awsClients.listMaintenanceWindowExecutions(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := d.Get(attStatus).(string)
+
+	var flattenedExecutions []interface{}
+
+	for _, execution := range executions {
+		if status != "" && string(execution.Status) != status {
+			continue
+		}
+
+		tasks, err := awsClients.listMaintenanceWindowExecutionTasks(ctx, aws.ToString(execution.WindowExecutionId))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var flattenedTasks []interface{}
+		for _, task := range tasks {
+			flattenedTasks = append(flattenedTasks, map[string]interface{}{
+				attTaskExecutionId: aws.ToString(task.TaskExecutionId),
+				attTaskArn:         aws.ToString(task.TaskArn),
+				attTaskType:        string(task.TaskType),
+				attStatus:          string(task.Status),
+				attStatusDetails:   aws.ToString(task.StatusDetails),
+				attStartTime:       formatTime(task.StartTime),
+				attEndTime:         formatTime(task.EndTime),
+			})
+		}
+
+		flattenedExecutions = append(flattenedExecutions, map[string]interface{}{
+			attWindowExecutionId: aws.ToString(execution.WindowExecutionId),
+			attStatus:            string(execution.Status),
+			attStatusDetails:     aws.ToString(execution.StatusDetails),
+			attStartTime:         formatTime(execution.StartTime),
+			attEndTime:           formatTime(execution.EndTime),
+			attTasks:             flattenedTasks,
+		})
+	}
+
+	d.SetId(windowId)
+
+	if err := d.Set(attExecutions, flattenedExecutions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceMaintenanceWindowExecutions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMaintenanceWindowExecutionsRead,
+		Schema: map[string]*schema.Schema{
+			attWindowId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attExecutedAfter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attExecutedBefore: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.MaintenanceWindowExecutionStatus("").Values()), false),
+			},
+			attExecutions: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attWindowExecutionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatusDetails: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attEndTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTasks: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attTaskExecutionId: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attTaskArn: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attTaskType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStatusDetails: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attStartTime: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									attEndTime: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
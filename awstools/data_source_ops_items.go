@@ -0,0 +1,255 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_ops_items data source
+const (
+	attCreatedAfter     string = "created_after"
+	attCreatedBefore    string = "created_before"
+	attOpsItems         string = "ops_items"
+	attCreatedTime      string = "created_time"
+	attLastModifiedTime string = "last_modified_time"
+)
+
+// Filter value of OpsItem operational data
+type OpsItemDataFilter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func getOpsItemFilters(d *schema.ResourceData) ([]ssmtypes.OpsItemFilter, error) {
+	var filters []ssmtypes.OpsItemFilter
+
+	lists := map[string]ssmtypes.OpsItemFilterKey{
+		attStatus:   ssmtypes.OpsItemFilterKeyStatus,
+		attSeverity: ssmtypes.OpsItemFilterKeySeverity,
+		attSource:   ssmtypes.OpsItemFilterKeySource,
+	}
+
+	for _, key := range sortedKeys(lists) {
+		if values := getStringList(d, key); len(values) > 0 {
+			filters = append(filters, ssmtypes.OpsItemFilter{
+				Key:      lists[key],
+				Values:   values,
+				Operator: ssmtypes.OpsItemFilterOperatorEqual,
+			})
+		}
+	}
+
+	times := map[string]ssmtypes.OpsItemFilterOperator{
+		attCreatedAfter:  ssmtypes.OpsItemFilterOperatorGreaterThan,
+		attCreatedBefore: ssmtypes.OpsItemFilterOperatorLessThan,
+	}
+
+	for _, key := range sortedKeys(times) {
+		if v, ok := d.GetOk(key); ok {
+			createdTime, _ := time.Parse(time.RFC3339, v.(string))
+			filters = append(filters, ssmtypes.OpsItemFilter{
+				Key:      ssmtypes.OpsItemFilterKeyCreatedTime,
+				Values:   []string{createdTime.UTC().Format(time.RFC3339)},
+				Operator: times[key],
+			})
+		}
+	}
+
+	operationalData := d.Get(attOperationalData).(map[string]interface{})
+
+	for _, key := range sortedKeys(operationalData) {
+		bytes, err := json.Marshal(OpsItemDataFilter{Key: key, Value: operationalData[key].(string)})
+
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, ssmtypes.OpsItemFilter{
+			Key:      ssmtypes.OpsItemFilterKeyOperationalData,
+			Values:   []string{string(bytes)},
+			Operator: ssmtypes.OpsItemFilterOperatorEqual,
+		})
+	}
+
+	return filters, nil
+}
+
+func dataSourceOpsItemsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	filters, err := getOpsItemFilters(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &ssm.DescribeOpsItemsInput{
+		OpsItemFilters: filters,
+	}
+
+	var ids []string
+	var opsItems []interface{}
+
+	for {
+		output, err := awsClients.ssmClient.DescribeOpsItems(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, summary := range output.OpsItemSummaries {
+			ids = append(ids, aws.ToString(summary.OpsItemId))
+
+			opsItems = append(opsItems, map[string]interface{}{
+				attOpsItemId:        aws.ToString(summary.OpsItemId),
+				attTitle:            aws.ToString(summary.Title),
+				attStatus:           string(summary.Status),
+				attSeverity:         aws.ToString(summary.Severity),
+				attSource:           aws.ToString(summary.Source),
+				attPriority:         int(aws.ToInt32(summary.Priority)),
+				attCategory:         aws.ToString(summary.Category),
+				attOpsItemType:      aws.ToString(summary.OpsItemType),
+				attCreatedTime:      formatTime(summary.CreatedTime),
+				attLastModifiedTime: formatTime(summary.LastModifiedTime),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attIds:      ids,
+		attOpsItems: opsItems,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceOpsItems() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsItemsRead,
+		Schema: map[string]*schema.Schema{
+			attStatus: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OpsItemStatus("").Values()), false),
+				},
+			},
+			attSeverity: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+				},
+			},
+			attSource: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attCreatedAfter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attCreatedBefore: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attOperationalData: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attOpsItems: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attOpsItemId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTitle: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attSeverity: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attSource: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attPriority: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attCategory: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOpsItemType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attCreatedTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attLastModifiedTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
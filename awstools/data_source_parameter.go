@@ -0,0 +1,132 @@
+package awstools
+
+import (
+	"context"
+	"strconv"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_parameter data source
+const (
+	attInsecureValue string = "insecure_value"
+	attLastModified  string = "last_modified"
+)
+
+func dataSourceParameterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+	withDecryption := d.Get(attWithDecryption).(bool)
+
+	// The version or the label is selected with the suffix of the parameter name.
+	selector := ""
+	if v, ok := d.GetOk(attVersion); ok {
+		selector = ":" + strconv.Itoa(v.(int))
+	} else if v, ok := d.GetOk(attLabel); ok {
+		selector = ":" + v.(string)
+	}
+
+	parameter, err := awsClients.GetParameter(ctx, name+selector, withDecryption)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parameter.Name == nil {
+		return diag.Errorf("parameter %s not found", name+selector)
+	}
+
+	d.SetId(*parameter.Name)
+
+	values := map[string]interface{}{
+		attType:          parameter.Type,
+		attValue:         parameter.Value,
+		attInsecureValue: "",
+		attDataType:      parameter.DataType,
+		attArn:           parameter.ARN,
+		attSelector:      parameter.Selector,
+		attLastModified:  formatTime(parameter.LastModifiedDate),
+	}
+
+	// Version is an argument when the version is selected.
+	if _, ok := d.GetOk(attVersion); !ok {
+		values[attVersion] = parameter.Version
+	}
+
+	if parameter.Type != ssmtypes.ParameterTypeSecureString {
+		values[attInsecureValue] = parameter.Value
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceParameter() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceParameterRead,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attWithDecryption: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attVersion: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{attLabel},
+			},
+			attLabel: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{attVersion},
+			},
+			attType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attValue: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			attInsecureValue: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDataType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attSelector: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastModified: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,185 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_parameter_history data source
+const (
+	attVersions string = "versions"
+	attHistory  string = "history"
+	attLabels   string = "labels"
+)
+
+// Retrieves all the versions of SSM parameter, from the oldest to the latest.
+func (clients AwsClients) getParameterHistory(ctx context.Context, name string, withDecryption bool) ([]ssmtypes.ParameterHistory, error) {
+	var history []ssmtypes.ParameterHistory
+
+	input := &ssm.GetParameterHistoryInput{
+		Name:           &name,
+		WithDecryption: &withDecryption,
+	}
+
+	for {
+		output, err := clients.ssmClient.GetParameterHistory(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, output.Parameters...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return history, nil
+}
+
+func dataSourceParameterHistoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	history, err := awsClients.getParameterHistory(ctx, name, d.Get(attWithDecryption).(bool))
+
+	var notFound *ssmtypes.ParameterNotFound
+	if errors.As(err, &notFound) {
+		return diag.Errorf("parameter %s not found", name)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions := make([]int, 0, len(history))
+	var blocks []interface{}
+
+	for _, parameter := range history {
+		versions = append(versions, int(parameter.Version))
+
+		blocks = append(blocks, map[string]interface{}{
+			attVersion:          int(parameter.Version),
+			attValue:            aws.ToString(parameter.Value),
+			attType:             string(parameter.Type),
+			attDataType:         aws.ToString(parameter.DataType),
+			attDescription:      aws.ToString(parameter.Description),
+			attTier:             string(parameter.Tier),
+			attLabels:           parameter.Labels,
+			attLastModified:     formatTime(parameter.LastModifiedDate),
+			attLastModifiedUser: aws.ToString(parameter.LastModifiedUser),
+		})
+	}
+
+	latestVersion := 0
+	if len(versions) > 0 {
+		latestVersion = versions[len(versions)-1]
+	}
+
+	d.SetId(name)
+
+	values := map[string]interface{}{
+		attVersions:      versions,
+		attLatestVersion: latestVersion,
+		attHistory:       blocks,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceParameterHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceParameterHistoryRead,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attWithDecryption: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attVersions: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			attLatestVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attHistory: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attVersion: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attValue: {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						attType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDataType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTier: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attLabels: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attLastModified: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attLastModifiedUser: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_parameters_by_path data source
+const (
+	attPath            string = "path"
+	attRecursive       string = "recursive"
+	attParameterFilter string = "parameter_filter"
+	attOption          string = "option"
+	attNames           string = "names"
+	attTypes           string = "types"
+)
+
+func getParameterFilters(d *schema.ResourceData) []ssmtypes.ParameterStringFilter {
+	var filters []ssmtypes.ParameterStringFilter
+
+	for _, f := range d.Get(attParameterFilter).([]interface{}) {
+		block := f.(map[string]interface{})
+		filter := ssmtypes.ParameterStringFilter{
+			Key: aws.String(block[attKey].(string)),
+		}
+		for _, value := range block[attValues].([]interface{}) {
+			filter.Values = append(filter.Values, value.(string))
+		}
+		if v := block[attOption].(string); v != "" {
+			filter.Option = aws.String(v)
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+func dataSourceParametersByPathRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	path := d.Get(attPath).(string)
+
+	parameters, err := awsClients.getParametersByPath(ctx, &ssm.GetParametersByPathInput{
+		Path:             &path,
+		Recursive:        aws.Bool(d.Get(attRecursive).(bool)),
+		WithDecryption:   aws.Bool(d.Get(attWithDecryption).(bool)),
+		ParameterFilters: getParameterFilters(d),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameterValues := make(map[string]string)
+	parameterTypes := make(map[string]string)
+
+	for _, parameter := range parameters {
+		name := aws.ToString(parameter.Name)
+		parameterValues[name] = aws.ToString(parameter.Value)
+		parameterTypes[name] = string(parameter.Type)
+	}
+
+	d.SetId(path)
+
+	values := map[string]interface{}{
+		attNames:  sortedKeys(parameterValues),
+		attValues: parameterValues,
+		attTypes:  parameterTypes,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceParametersByPath() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceParametersByPathRead,
+		Schema: map[string]*schema.Schema{
+			attPath: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attRecursive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attWithDecryption: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attParameterFilter: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attOption: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Equals", "BeginsWith"}, false),
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attValues: {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attTypes: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
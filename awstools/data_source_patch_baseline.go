@@ -0,0 +1,242 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_patch_baseline data source
+const (
+	attDefaultBaseline string = "default_baseline"
+)
+
+// Finds Id of the patch baseline matching the arguments.
+// The default baseline of the operating system is looked up directly when requested.
+func (clients AwsClients) findPatchBaselineId(ctx context.Context, d *schema.ResourceData) (string, error) {
+	operatingSystem := d.Get(attOperatingSystem).(string)
+	defaultBaseline := d.Get(attDefaultBaseline).(bool)
+
+	if defaultBaseline && d.Get(attOwner).(string) == "" && d.Get(attNamePrefix).(string) == "" {
+		output, err := clients.ssmClient.GetDefaultPatchBaseline(ctx, &ssm.GetDefaultPatchBaselineInput{
+			OperatingSystem: ssmtypes.OperatingSystem(operatingSystem),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(output.BaselineId), nil
+	}
+
+	var filters []ssmtypes.PatchOrchestratorFilter
+
+	arguments := map[string]string{
+		attNamePrefix:      ssmPatchBaselineFilterNamePrefix,
+		attOwner:           ssmPatchBaselineFilterOwner,
+		attOperatingSystem: ssmPatchBaselineFilterOperatingSystem,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			filters = append(filters, ssmtypes.PatchOrchestratorFilter{Key: aws.String(arguments[key]), Values: []string{v.(string)}})
+		}
+	}
+
+	input := &ssm.DescribePatchBaselinesInput{
+		Filters: filters,
+	}
+
+	var baselineIds []string
+
+	for {
+		output, err := clients.ssmClient.DescribePatchBaselines(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, baseline := range output.BaselineIdentities {
+			if defaultBaseline && !baseline.DefaultBaseline {
+				continue
+			}
+			baselineIds = append(baselineIds, aws.ToString(baseline.BaselineId))
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	switch len(baselineIds) {
+	case 0:
+		return "", errors.New("no patch baseline matches the arguments")
+	case 1:
+		return baselineIds[0], nil
+	default:
+		return "", fmt.Errorf("%d patch baselines match the arguments, use more specific arguments", len(baselineIds))
+	}
+}
+
+func dataSourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId, err := awsClients.findPatchBaselineId(ctx, d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	baseline, err := awsClients.ssmClient.GetPatchBaseline(ctx, &ssm.GetPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(baselineId)
+
+	values := map[string]interface{}{
+		attName:                             baseline.Name,
+		attDescription:                      baseline.Description,
+		attOperatingSystem:                  baseline.OperatingSystem,
+		attGlobalFilters:                    flattenPatchFilterGroup(baseline.GlobalFilters),
+		attApprovalRules:                    flattenApprovalRules(baseline.ApprovalRules),
+		attApprovedPatches:                  baseline.ApprovedPatches,
+		attApprovedPatchesComplianceLevel:   baseline.ApprovedPatchesComplianceLevel,
+		attApprovedPatchesEnableNonSecurity: baseline.ApprovedPatchesEnableNonSecurity,
+		attRejectedPatches:                  baseline.RejectedPatches,
+		attRejectedPatchesAction:            baseline.RejectedPatchesAction,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourcePatchBaseline() *schema.Resource {
+	patchFilters := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attKey: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				attValues: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePatchBaselineRead,
+		Schema: map[string]*schema.Schema{
+			attOwner: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Self", "AWS", "All"}, false),
+			},
+			attNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOperatingSystem: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OperatingSystem("").Values()), false),
+			},
+			attDefaultBaseline: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attGlobalFilters: patchFilters,
+			attApprovalRules: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attPatchFilters: patchFilters,
+						attApproveAfterDays: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attApproveUntilDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attComplianceLevel: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attEnableNonSecurity: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			attApprovedPatches: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attApprovedPatchesComplianceLevel: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attApprovedPatchesEnableNonSecurity: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			attRejectedPatches: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attRejectedPatchesAction: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,167 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_patch_group_state data source
+const (
+	attInstanceCount                     string = "instance_count"
+	attMissingInstanceIds                string = "missing_instance_ids"
+	attFailedInstanceIds                 string = "failed_instance_ids"
+	attInstalledPendingRebootInstanceIds string = "installed_pending_reboot_instance_ids"
+	attInstallRequired                   string = "install_required"
+)
+
+func dataSourcePatchGroupStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	patchGroup := d.Get(attPatchGroup).(string)
+
+	state, err := awsClients.ssmClient.DescribePatchGroupState(ctx, &ssm.DescribePatchGroupStateInput{
+		PatchGroup: &patchGroup,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The patch group state only has counts, the instances are listed from their patch states.
+	patchStates, err := awsClients.describeInstancePatchStatesForPatchGroup(ctx, patchGroup)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var missingInstanceIds []string
+	var failedInstanceIds []string
+	var installedPendingRebootInstanceIds []string
+
+	for _, patchState := range patchStates {
+		instanceId := aws.ToString(patchState.InstanceId)
+
+		if patchState.MissingCount > 0 {
+			missingInstanceIds = append(missingInstanceIds, instanceId)
+		}
+		if patchState.FailedCount > 0 {
+			failedInstanceIds = append(failedInstanceIds, instanceId)
+		}
+		if aws.ToInt32(patchState.InstalledPendingRebootCount) > 0 {
+			installedPendingRebootInstanceIds = append(installedPendingRebootInstanceIds, instanceId)
+		}
+	}
+
+	d.SetId(patchGroup)
+
+	values := map[string]interface{}{
+		attInstanceCount:                     int(state.Instances),
+		attInstalledCount:                    int(state.InstancesWithInstalledPatches),
+		attInstalledOtherCount:               int(state.InstancesWithInstalledOtherPatches),
+		attInstalledPendingRebootCount:       int(aws.ToInt32(state.InstancesWithInstalledPendingRebootPatches)),
+		attInstalledRejectedCount:            int(aws.ToInt32(state.InstancesWithInstalledRejectedPatches)),
+		attMissingCount:                      int(state.InstancesWithMissingPatches),
+		attFailedCount:                       int(state.InstancesWithFailedPatches),
+		attNotApplicableCount:                int(state.InstancesWithNotApplicablePatches),
+		attCriticalNonCompliantCount:         int(aws.ToInt32(state.InstancesWithCriticalNonCompliantPatches)),
+		attSecurityNonCompliantCount:         int(aws.ToInt32(state.InstancesWithSecurityNonCompliantPatches)),
+		attMissingInstanceIds:                missingInstanceIds,
+		attFailedInstanceIds:                 failedInstanceIds,
+		attInstalledPendingRebootInstanceIds: installedPendingRebootInstanceIds,
+		attInstallRequired:                   state.InstancesWithMissingPatches > 0 || state.InstancesWithFailedPatches > 0,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourcePatchGroupState() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePatchGroupStateRead,
+		Schema: map[string]*schema.Schema{
+			attPatchGroup: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attInstanceCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInstalledCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInstalledOtherCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInstalledPendingRebootCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attInstalledRejectedCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attMissingCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attFailedCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attNotApplicableCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attCriticalNonCompliantCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attSecurityNonCompliantCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attMissingInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attFailedInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInstalledPendingRebootInstanceIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInstallRequired: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
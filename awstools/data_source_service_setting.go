@@ -0,0 +1,90 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_service_setting data source
+const (
+	attLastModifiedUser string = "last_modified_user"
+)
+
+func dataSourceServiceSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	settingId := d.Get(attSettingId).(string)
+
+	output, err := awsClients.ssmClient.GetServiceSetting(ctx, &ssm.GetServiceSettingInput{
+		SettingId: &settingId,
+	})
+
+	var notFound *ssmtypes.ServiceSettingNotFound
+	if errors.As(err, &notFound) {
+		return diag.Errorf("service setting %s not found", settingId)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(settingId)
+
+	values := map[string]interface{}{
+		attSettingValue:     output.ServiceSetting.SettingValue,
+		attStatus:           output.ServiceSetting.Status,
+		attArn:              output.ServiceSetting.ARN,
+		attLastModified:     formatTime(output.ServiceSetting.LastModifiedDate),
+		attLastModifiedUser: output.ServiceSetting.LastModifiedUser,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceServiceSetting() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServiceSettingRead,
+		Schema: map[string]*schema.Schema{
+			attSettingId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attSettingValue: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastModified: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastModifiedUser: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
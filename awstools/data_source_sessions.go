@@ -0,0 +1,208 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_sessions data source
+const (
+	attInvokedAfter  string = "invoked_after"
+	attInvokedBefore string = "invoked_before"
+	attSessions      string = "sessions"
+	attReason        string = "reason"
+)
+
+func getSessionFilters(d *schema.ResourceData) []ssmtypes.SessionFilter {
+	var filters []ssmtypes.SessionFilter
+
+	arguments := map[string]ssmtypes.SessionFilterKey{
+		attTarget: ssmtypes.SessionFilterKeyTargetId,
+		attOwner:  ssmtypes.SessionFilterKeyOwner,
+		attStatus: ssmtypes.SessionFilterKeyStatus,
+	}
+
+	for _, key := range sortedKeys(arguments) {
+		if v, ok := d.GetOk(key); ok {
+			filters = append(filters, ssmtypes.SessionFilter{
+				Key:   arguments[key],
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
+	times := map[string]ssmtypes.SessionFilterKey{
+		attInvokedAfter:  ssmtypes.SessionFilterKeyInvokedAfter,
+		attInvokedBefore: ssmtypes.SessionFilterKeyInvokedBefore,
+	}
+
+	for _, key := range sortedKeys(times) {
+		if v, ok := d.GetOk(key); ok {
+			invokedTime, _ := time.Parse(time.RFC3339, v.(string))
+			filters = append(filters, ssmtypes.SessionFilter{
+				Key:   times[key],
+				Value: aws.String(invokedTime.UTC().Format(time.RFC3339)),
+			})
+		}
+	}
+
+	return filters
+}
+
+func dataSourceSessionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.DescribeSessionsInput{
+		State:   ssmtypes.SessionState(d.Get(attState).(string)),
+		Filters: getSessionFilters(d),
+	}
+
+	var ids []string
+	var sessions []interface{}
+
+	for {
+		output, err := awsClients.ssmClient.DescribeSessions(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, session := range output.Sessions {
+			ids = append(ids, aws.ToString(session.SessionId))
+
+			sessions = append(sessions, map[string]interface{}{
+				attSessionId:          aws.ToString(session.SessionId),
+				attTarget:             aws.ToString(session.Target),
+				attStatus:             string(session.Status),
+				attOwner:              aws.ToString(session.Owner),
+				attDocumentName:       aws.ToString(session.DocumentName),
+				attReason:             aws.ToString(session.Reason),
+				attStartDate:          formatTime(session.StartDate),
+				attEndDate:            formatTime(session.EndDate),
+				attMaxSessionDuration: aws.ToString(session.MaxSessionDuration),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(getContentSha256([]byte(strings.Join(ids, ","))))
+
+	values := map[string]interface{}{
+		attIds:      ids,
+		attSessions: sessions,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func dataSourceSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSessionsRead,
+		Schema: map[string]*schema.Schema{
+			attState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.SessionStateActive),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.SessionState("").Values()), false),
+			},
+			attTarget: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOwner: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.SessionStatus("").Values()), false),
+			},
+			attInvokedAfter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attInvokedBefore: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attSessions: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attSessionId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attTarget: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOwner: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attDocumentName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStartDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attEndDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attMaxSessionDuration: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
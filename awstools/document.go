@@ -0,0 +1,111 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const documentWaitTimeout = 300
+
+// Wait until the SSM document status is active
+func (clients AwsClients) waitForDocumentActive(ctx context.Context, name string, waitTimeout int) (ssmtypes.DocumentDescription, error) {
+	deadline := time.Now().Add(time.Duration(waitTimeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		document, err := clients.GetDocument(ctx, name)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return ssmtypes.DocumentDescription{}, err
+		}
+
+		if document.Name == nil {
+			return ssmtypes.DocumentDescription{}, fmt.Errorf("document %s not found", name)
+		}
+
+		switch document.Status {
+		case ssmtypes.DocumentStatusActive:
+			return document, nil
+		case ssmtypes.DocumentStatusFailed:
+			return ssmtypes.DocumentDescription{}, fmt.Errorf("document %s failed: %s", name, aws.ToString(document.StatusInformation))
+		}
+
+		log.Info(ctx, fmt.Sprintf("Document %s status is %s.", name, document.Status))
+	}
+
+	log.Error(ctx, "Document is not active.")
+
+	return ssmtypes.DocumentDescription{}, errors.New("document is not active")
+}
+
+// Retrieves SSM document description by name.
+func (clients AwsClients) GetDocument(ctx context.Context, name string) (ssmtypes.DocumentDescription, error) {
+	output, err := clients.ssmClient.DescribeDocument(ctx, &ssm.DescribeDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if errors.As(err, &notFound) {
+		return ssmtypes.DocumentDescription{}, nil
+	}
+
+	if err != nil {
+		return ssmtypes.DocumentDescription{}, err
+	}
+
+	return *output.Document, nil
+}
+
+// Builds ARN of SSM document, documents owned by AWS have no account Id in the ARN.
+func (clients AwsClients) documentArn(owner string, name string) string {
+	region := clients.ssmClient.Options().Region
+
+	partition := "aws"
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		partition = "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		partition = "aws-us-gov"
+	}
+
+	if owner == "Amazon" {
+		owner = ""
+	}
+
+	return fmt.Sprintf("arn:%s:ssm:%s:%s:document/%s", partition, region, owner, name)
+}
+
+// Retrieves SSM documents matching all the filters.
+func (clients AwsClients) listDocuments(ctx context.Context, filters []ssmtypes.DocumentKeyValuesFilter) ([]ssmtypes.DocumentIdentifier, error) {
+	var documents []ssmtypes.DocumentIdentifier
+
+	input := &ssm.ListDocumentsInput{
+		Filters: filters,
+	}
+
+	for {
+		output, err := clients.ssmClient.ListDocuments(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, output.DocumentIdentifiers...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return documents, nil
+}
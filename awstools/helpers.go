@@ -0,0 +1,127 @@
+package awstools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Converts values of AWS SDK enum type to strings for schema validation.
+func enumValues[T ~string](values []T) []string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		result = append(result, string(value))
+	}
+
+	return result
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func setToStrings(set *schema.Set) []string {
+	var values []string
+
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+
+	return values
+}
+
+func getStringSet(d *schema.ResourceData, key string) []string {
+	return setToStrings(d.Get(key).(*schema.Set))
+}
+
+func getStringList(d *schema.ResourceData, key string) []string {
+	var values []string
+
+	for _, value := range d.Get(key).([]interface{}) {
+		values = append(values, value.(string))
+	}
+
+	return values
+}
+
+func expandStringMap(m map[string]interface{}) map[string]string {
+	values := make(map[string]string)
+
+	for key, value := range m {
+		values[key] = value.(string)
+	}
+
+	return values
+}
+
+// Formats boolean document parameter, AWS documents expect capitalized values.
+func documentBool(value bool) string {
+	if value {
+		return "True"
+	}
+
+	return "False"
+}
+
+// Splits composite resource Id of the form "part1/part2/...".
+func parseResourceId(id string, count int) ([]string, error) {
+	parts := strings.SplitN(id, "/", count)
+
+	if len(parts) != count {
+		return nil, fmt.Errorf("unexpected format of Id (%s), expected %d parts separated by '/'", id, count)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of Id (%s), expected %d parts separated by '/'", id, count)
+		}
+	}
+
+	return parts, nil
+}
+
+// Suppresses the difference of the equal timestamps in different RFC3339 formats.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// Formats the optional timestamp in RFC3339, empty if not set.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Quotes the string for POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Quotes the string for PowerShell.
+func powershellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,154 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SSM instance information filter keys
+var ssmInstanceFilterInstanceIds = "InstanceIds"
+
+const (
+	ssmInstanceFilterPlatformTypes = "PlatformTypes"
+	ssmInstanceFilterPingStatus    = "PingStatus"
+	ssmInstanceFilterAgentVersion  = "AgentVersion"
+)
+
+// InstanceIds filter accepts up to 50 instance Ids
+const instanceInformationBatchSize = 50
+
+// Retrieves SSM managed instance information by instance Id.
+func (clients AwsClients) GetInstanceInformation(ctx context.Context, instanceId string) (ssmtypes.InstanceInformation, error) {
+	output, err := clients.ssmClient.DescribeInstanceInformation(ctx, &ssm.DescribeInstanceInformationInput{
+		Filters: []ssmtypes.InstanceInformationStringFilter{
+			{
+				Key:    &ssmInstanceFilterInstanceIds,
+				Values: []string{instanceId},
+			},
+		},
+	})
+
+	if err != nil {
+		return ssmtypes.InstanceInformation{}, err
+	}
+
+	if len(output.InstanceInformationList) == 0 {
+		return ssmtypes.InstanceInformation{}, nil
+	}
+
+	return output.InstanceInformationList[0], nil
+}
+
+// Retrieves SSM managed instances information by instance Ids.
+func (clients AwsClients) listInstanceInformation(ctx context.Context, instanceIds []string) ([]ssmtypes.InstanceInformation, error) {
+	var instances []ssmtypes.InstanceInformation
+
+	for start := 0; start < len(instanceIds); start += instanceInformationBatchSize {
+		end := min(start+instanceInformationBatchSize, len(instanceIds))
+
+		input := &ssm.DescribeInstanceInformationInput{
+			Filters: []ssmtypes.InstanceInformationStringFilter{
+				{
+					Key:    &ssmInstanceFilterInstanceIds,
+					Values: instanceIds[start:end],
+				},
+			},
+		}
+
+		for {
+			output, err := clients.ssmClient.DescribeInstanceInformation(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			instances = append(instances, output.InstanceInformationList...)
+
+			if output.NextToken == nil {
+				break
+			}
+
+			input.NextToken = output.NextToken
+		}
+	}
+
+	return instances, nil
+}
+
+// Wait until SSM Agent of the managed instances is online.
+// Waits for the expected agent version too if the version is specified.
+func (clients AwsClients) waitForAgentVersion(ctx context.Context, instanceIds []string, version string, waitTimeout int) error {
+	deadline := time.Now().Add(time.Duration(waitTimeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		instances, err := clients.listInstanceInformation(ctx, instanceIds)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return err
+		}
+
+		readyInstanceCount := 0
+
+		for _, instance := range instances {
+			if instance.PingStatus == ssmtypes.PingStatusOnline && (version == "" || aws.ToString(instance.AgentVersion) == version) {
+				readyInstanceCount += 1
+			}
+		}
+
+		log.Info(ctx, fmt.Sprintf("%d of %d instances report SSM Agent online.", readyInstanceCount, len(instanceIds)))
+
+		if readyInstanceCount == len(instanceIds) {
+			return nil
+		}
+	}
+
+	log.Error(ctx, "SSM Agents are not online.")
+
+	return errors.New("SSM Agents are not online at the expected version")
+}
+
+// Retrieves SSM managed instances information of the command targets.
+func (clients AwsClients) listTargetInstances(ctx context.Context, ssmTargets []ssmtypes.Target) ([]ssmtypes.InstanceInformation, error) {
+	var filters []ssmtypes.InstanceInformationStringFilter
+
+	for _, target := range ssmTargets {
+		filters = append(filters, ssmtypes.InstanceInformationStringFilter{Key: target.Key, Values: target.Values})
+	}
+
+	return clients.listInstances(ctx, filters)
+}
+
+// Retrieves SSM managed instances information matching all the filters.
+func (clients AwsClients) listInstances(ctx context.Context, filters []ssmtypes.InstanceInformationStringFilter) ([]ssmtypes.InstanceInformation, error) {
+	var instances []ssmtypes.InstanceInformation
+
+	input := &ssm.DescribeInstanceInformationInput{
+		Filters: filters,
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribeInstanceInformation(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, output.InstanceInformationList...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return instances, nil
+}
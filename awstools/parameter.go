@@ -0,0 +1,114 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// SSM parameter filter keys
+var ssmParameterFilterName = "Name"
+var ssmParameterFilterOptionEquals = "Equals"
+
+// Retrieves SSM parameter by name.
+func (clients AwsClients) GetParameter(ctx context.Context, name string, withDecryption bool) (ssmtypes.Parameter, error) {
+	output, err := clients.ssmClient.GetParameter(ctx, &ssm.GetParameterInput{
+		Name:           &name,
+		WithDecryption: &withDecryption,
+	})
+
+	var notFound *ssmtypes.ParameterNotFound
+	if errors.As(err, &notFound) {
+		return ssmtypes.Parameter{}, nil
+	}
+
+	if err != nil {
+		return ssmtypes.Parameter{}, err
+	}
+
+	return *output.Parameter, nil
+}
+
+// Retrieves SSM parameter metadata by name.
+func (clients AwsClients) describeParameter(ctx context.Context, name string) (ssmtypes.ParameterMetadata, error) {
+	output, err := clients.ssmClient.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{
+			{
+				Key:    &ssmParameterFilterName,
+				Option: &ssmParameterFilterOptionEquals,
+				Values: []string{name},
+			},
+		},
+	})
+
+	if err != nil {
+		return ssmtypes.ParameterMetadata{}, err
+	}
+
+	if len(output.Parameters) == 0 {
+		return ssmtypes.ParameterMetadata{}, nil
+	}
+
+	return output.Parameters[0], nil
+}
+
+// Retrieves the version of SSM parameter the label is attached to.
+// Returns 0 when the parameter does not exist or no version has the label.
+func (clients AwsClients) getParameterLabelVersion(ctx context.Context, name string, label string) (int64, error) {
+	input := &ssm.GetParameterHistoryInput{
+		Name: &name,
+	}
+
+	for {
+		output, err := clients.ssmClient.GetParameterHistory(ctx, input)
+
+		var notFound *ssmtypes.ParameterNotFound
+		if errors.As(err, &notFound) {
+			return 0, nil
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		for _, history := range output.Parameters {
+			if slices.Contains(history.Labels, label) {
+				return history.Version, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return 0, nil
+}
+
+// Retrieves SSM parameters under the path hierarchy.
+func (clients AwsClients) getParametersByPath(ctx context.Context, input *ssm.GetParametersByPathInput) ([]ssmtypes.Parameter, error) {
+	var parameters []ssmtypes.Parameter
+
+	for {
+		output, err := clients.ssmClient.GetParametersByPath(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parameters = append(parameters, output.Parameters...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return parameters, nil
+}
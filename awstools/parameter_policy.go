@@ -0,0 +1,178 @@
+package awstools
+
+import (
+	"encoding/json"
+	"strconv"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_parameter resource policies
+const (
+	attExpirationPolicy             string = "expiration_policy"
+	attExpirationNotificationPolicy string = "expiration_notification_policy"
+	attNoChangeNotificationPolicy   string = "no_change_notification_policy"
+	attTimestamp                    string = "timestamp"
+	attBefore                       string = "before"
+	attAfter                        string = "after"
+	attUnit                         string = "unit"
+)
+
+// Parameter policy types
+const (
+	policyTypeExpiration             = "Expiration"
+	policyTypeExpirationNotification = "ExpirationNotification"
+	policyTypeNoChangeNotification   = "NoChangeNotification"
+)
+
+const parameterPolicyVersion = "1.0"
+
+type ParameterPolicy struct {
+	Type       string            `json:"Type"`
+	Version    string            `json:"Version"`
+	Attributes map[string]string `json:"Attributes"`
+}
+
+func parameterPolicySchema(attribute string) *schema.Schema {
+	policy := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			attUnit: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Days",
+				ValidateFunc: validation.StringInSlice([]string{"Days", "Hours"}, false),
+			},
+		},
+	}
+
+	switch attribute {
+	case attExpirationPolicy:
+		policy.Schema = map[string]*schema.Schema{
+			attTimestamp: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		}
+	case attExpirationNotificationPolicy:
+		policy.Schema[attBefore] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+	case attNoChangeNotificationPolicy:
+		policy.Schema[attAfter] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     policy,
+	}
+}
+
+// Builds SSM parameter policies JSON document from the policy blocks.
+func getParameterPolicies(d *schema.ResourceData) (string, error) {
+	policies := make([]ParameterPolicy, 0)
+
+	if v := d.Get(attExpirationPolicy).([]interface{}); len(v) > 0 && v[0] != nil {
+		block := v[0].(map[string]interface{})
+		policies = append(policies, ParameterPolicy{
+			Type:    policyTypeExpiration,
+			Version: parameterPolicyVersion,
+			Attributes: map[string]string{
+				"Timestamp": block[attTimestamp].(string),
+			},
+		})
+	}
+
+	if v := d.Get(attExpirationNotificationPolicy).([]interface{}); len(v) > 0 && v[0] != nil {
+		block := v[0].(map[string]interface{})
+		policies = append(policies, ParameterPolicy{
+			Type:    policyTypeExpirationNotification,
+			Version: parameterPolicyVersion,
+			Attributes: map[string]string{
+				"Before": strconv.Itoa(block[attBefore].(int)),
+				"Unit":   block[attUnit].(string),
+			},
+		})
+	}
+
+	if v := d.Get(attNoChangeNotificationPolicy).([]interface{}); len(v) > 0 && v[0] != nil {
+		block := v[0].(map[string]interface{})
+		policies = append(policies, ParameterPolicy{
+			Type:    policyTypeNoChangeNotification,
+			Version: parameterPolicyVersion,
+			Attributes: map[string]string{
+				"After": strconv.Itoa(block[attAfter].(int)),
+				"Unit":  block[attUnit].(string),
+			},
+		})
+	}
+
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	bytes, err := json.Marshal(policies)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// Converts the policies attached to SSM parameter to the policy blocks.
+func flattenParameterPolicies(inlinePolicies []ssmtypes.ParameterInlinePolicy) (map[string]interface{}, error) {
+	blocks := map[string]interface{}{
+		attExpirationPolicy:             []interface{}{},
+		attExpirationNotificationPolicy: []interface{}{},
+		attNoChangeNotificationPolicy:   []interface{}{},
+	}
+
+	for _, inlinePolicy := range inlinePolicies {
+		if inlinePolicy.PolicyText == nil {
+			continue
+		}
+
+		var policy ParameterPolicy
+		if err := json.Unmarshal([]byte(*inlinePolicy.PolicyText), &policy); err != nil {
+			return nil, err
+		}
+
+		switch policy.Type {
+		case policyTypeExpiration:
+			blocks[attExpirationPolicy] = []interface{}{
+				map[string]interface{}{
+					attTimestamp: policy.Attributes["Timestamp"],
+				},
+			}
+		case policyTypeExpirationNotification:
+			before, _ := strconv.Atoi(policy.Attributes["Before"])
+			blocks[attExpirationNotificationPolicy] = []interface{}{
+				map[string]interface{}{
+					attBefore: before,
+					attUnit:   policy.Attributes["Unit"],
+				},
+			}
+		case policyTypeNoChangeNotification:
+			after, _ := strconv.Atoi(policy.Attributes["After"])
+			blocks[attNoChangeNotificationPolicy] = []interface{}{
+				map[string]interface{}{
+					attAfter: after,
+					attUnit:  policy.Attributes["Unit"],
+				},
+			}
+		}
+	}
+
+	return blocks, nil
+}
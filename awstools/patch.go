@@ -0,0 +1,79 @@
+package awstools
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// DescribeInstancePatchStates accepts up to 50 instance Ids
+const patchStatesBatchSize = 50
+
+// Retrieves patch summaries of the instances.
+func (clients AwsClients) describeInstancePatchStates(ctx context.Context, instanceIds []string) ([]ssmtypes.InstancePatchState, error) {
+	var patchStates []ssmtypes.InstancePatchState
+
+	for start := 0; start < len(instanceIds); start += patchStatesBatchSize {
+		end := min(start+patchStatesBatchSize, len(instanceIds))
+
+		input := &ssm.DescribeInstancePatchStatesInput{
+			InstanceIds: instanceIds[start:end],
+		}
+
+		for {
+			output, err := clients.ssmClient.DescribeInstancePatchStates(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			patchStates = append(patchStates, output.InstancePatchStates...)
+
+			if output.NextToken == nil {
+				break
+			}
+
+			input.NextToken = output.NextToken
+		}
+	}
+
+	sort.Slice(patchStates, func(i, j int) bool {
+		return aws.ToString(patchStates[i].InstanceId) < aws.ToString(patchStates[j].InstanceId)
+	})
+
+	return patchStates, nil
+}
+
+// Retrieves patch summaries of the instances of the patch group.
+func (clients AwsClients) describeInstancePatchStatesForPatchGroup(ctx context.Context, patchGroup string) ([]ssmtypes.InstancePatchState, error) {
+	var patchStates []ssmtypes.InstancePatchState
+
+	input := &ssm.DescribeInstancePatchStatesForPatchGroupInput{
+		PatchGroup: &patchGroup,
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribeInstancePatchStatesForPatchGroup(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		patchStates = append(patchStates, output.InstancePatchStates...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	sort.Slice(patchStates, func(i, j int) bool {
+		return aws.ToString(patchStates[i].InstanceId) < aws.ToString(patchStates[j].InstanceId)
+	})
+
+	return patchStates, nil
+}
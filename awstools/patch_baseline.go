@@ -0,0 +1,54 @@
+package awstools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// SSM patch baseline filter keys
+var ssmPatchBaselineFilterNamePrefix = "NAME_PREFIX"
+var ssmPatchBaselineFilterOwner = "OWNER"
+var ssmPatchBaselineFilterOperatingSystem = "OPERATING_SYSTEM"
+
+// Retrieves Id of the AWS provided default patch baseline for the operating system.
+func (clients AwsClients) getAwsDefaultPatchBaselineId(ctx context.Context, operatingSystem string) (string, error) {
+	input := &ssm.DescribePatchBaselinesInput{
+		Filters: []ssmtypes.PatchOrchestratorFilter{
+			{
+				Key:    &ssmPatchBaselineFilterOwner,
+				Values: []string{"AWS"},
+			},
+			{
+				Key:    &ssmPatchBaselineFilterOperatingSystem,
+				Values: []string{operatingSystem},
+			},
+		},
+	}
+
+	for {
+		output, err := clients.ssmClient.DescribePatchBaselines(ctx, input)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, baseline := range output.BaselineIdentities {
+			if strings.HasSuffix(aws.ToString(baseline.BaselineName), "DefaultPatchBaseline") {
+				return aws.ToString(baseline.BaselineId), nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return "", fmt.Errorf("AWS default patch baseline for %s operating system not found", operatingSystem)
+}
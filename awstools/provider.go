@@ -8,22 +8,102 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider -
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"ssm_command": resourceCommand(),
+			"ssm_activation":                resourceActivation(),
+			"ssm_agent_update":              resourceAgentUpdate(),
+			"ssm_ansible_playbook":          resourceAnsiblePlaybook(),
+			"ssm_association":               resourceAssociation(),
+			"ssm_automation_execution":      resourceAutomationExecution(),
+			"ssm_automation_signal":         resourceAutomationSignal(),
+			"ssm_change_calendar":           resourceChangeCalendar(),
+			"ssm_change_request":            resourceChangeRequest(),
+			"ssm_change_template":           resourceChangeTemplate(),
+			"ssm_cloudwatch_agent":          resourceCloudWatchAgent(),
+			"ssm_command":                   resourceCommand(),
+			"ssm_command_sequence":          resourceCommandSequence(),
+			"ssm_compliance_item":           resourceComplianceItem(),
+			"ssm_custom_inventory":          resourceCustomInventory(),
+			"ssm_default_patch_baseline":    resourceDefaultPatchBaseline(),
+			"ssm_distributor_package":       resourceDistributorPackage(),
+			"ssm_docker_compose":            resourceDockerCompose(),
+			"ssm_document":                  resourceDocument(),
+			"ssm_document_permission":       resourceDocumentPermission(),
+			"ssm_dsc_configuration":         resourceDscConfiguration(),
+			"ssm_file":                      resourceFile(),
+			"ssm_instance_reboot":           resourceInstanceReboot(),
+			"ssm_inventory_collection":      resourceInventoryCollection(),
+			"ssm_maintenance_window":        resourceMaintenanceWindow(),
+			"ssm_maintenance_window_target": resourceMaintenanceWindowTarget(),
+			"ssm_maintenance_window_task":   resourceMaintenanceWindowTask(),
+			"ssm_managed_instance":          resourceManagedInstance(),
+			"ssm_ops_item":                  resourceOpsItem(),
+			"ssm_ops_item_related_item":     resourceOpsItemRelatedItem(),
+			"ssm_ops_metadata":              resourceOpsMetadata(),
+			"ssm_package_install":           resourcePackageInstall(),
+			"ssm_parameter":                 resourceParameter(),
+			"ssm_parameter_label":           resourceParameterLabel(),
+			"ssm_patch_baseline":            resourcePatchBaseline(),
+			"ssm_patch_group":               resourcePatchGroup(),
+			"ssm_patch_install":             resourcePatchInstall(),
+			"ssm_patch_scan":                resourcePatchScan(),
+			"ssm_port_forward":              resourcePortForward(),
+			"ssm_quick_setup_configuration": resourceQuickSetupConfiguration(),
+			"ssm_remote_state":              resourceRemoteState(),
+			"ssm_resource_data_sync":        resourceResourceDataSync(),
+			"ssm_scheduled_command":         resourceScheduledCommand(),
+			"ssm_script":                    resourceScript(),
+			"ssm_service_setting":           resourceServiceSetting(),
+			"ssm_service_state":             resourceServiceState(),
+			"ssm_session_preferences":       resourceSessionPreferences(),
+			"ssm_windows_update":            resourceWindowsUpdate(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"ssm_activations":                   dataSourceActivations(),
+			"ssm_automation_execution":          dataSourceAutomationExecution(),
+			"ssm_command":                       dataSourceCommand(),
+			"ssm_command_invocation":            dataSourceCommandInvocation(),
+			"ssm_command_invocations":           dataSourceCommandInvocations(),
+			"ssm_command_query":                 dataSourceCommandQuery(),
+			"ssm_command_s3_output":             dataSourceCommandS3Output(),
+			"ssm_compliance_summary":            dataSourceComplianceSummary(),
+			"ssm_connection_status":             dataSourceConnectionStatus(),
+			"ssm_default_patch_baseline":        dataSourceDefaultPatchBaseline(),
+			"ssm_document":                      dataSourceDocument(),
+			"ssm_document_content":              dataSourceDocumentContent(),
+			"ssm_documents":                     dataSourceDocuments(),
+			"ssm_fleet_summary":                 dataSourceFleetSummary(),
+			"ssm_instance_associations":         dataSourceInstanceAssociations(),
+			"ssm_instance_information":          dataSourceInstanceInformation(),
+			"ssm_instance_patch_states":         dataSourceInstancePatchStates(),
+			"ssm_instances":                     dataSourceInstances(),
+			"ssm_inventory":                     dataSourceInventory(),
+			"ssm_inventory_schema":              dataSourceInventorySchema(),
+			"ssm_maintenance_window":            dataSourceMaintenanceWindow(),
+			"ssm_maintenance_window_executions": dataSourceMaintenanceWindowExecutions(),
+			"ssm_ops_items":                     dataSourceOpsItems(),
+			"ssm_parameter":                     dataSourceParameter(),
+			"ssm_parameter_history":             dataSourceParameterHistory(),
+			"ssm_parameters_by_path":            dataSourceParametersByPath(),
+			"ssm_patch_baseline":                dataSourcePatchBaseline(),
+			"ssm_patch_group_state":             dataSourcePatchGroupState(),
+			"ssm_service_setting":               dataSourceServiceSetting(),
+			"ssm_sessions":                      dataSourceSessions(),
 		},
-		DataSourcesMap: map[string]*schema.Resource{},
 		Schema: map[string]*schema.Schema{
 			"assume_role": assumeRoleSchema(),
 			"region": {
@@ -32,6 +112,13 @@ func Provider() *schema.Provider {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"poll_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      sleepTime,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The interval in seconds between the polls of the waits, e.g. for the command invocations.",
+			},
 		},
 	}
 
@@ -105,9 +192,12 @@ func configure(ctx context.Context, d *schema.ResourceData) (*AwsClients, diag.D
 	}
 
 	return &AwsClients{
-		ec2Client: ec2.NewFromConfig(cfg),
-		ssmClient: ssm.NewFromConfig(cfg),
-		s3Client:  s3.NewFromConfig(cfg),
+		ec2Client:            ec2.NewFromConfig(cfg),
+		ssmClient:            ssm.NewFromConfig(cfg),
+		s3Client:             s3.NewFromConfig(cfg),
+		quickSetupClient:     ssmquicksetup.NewFromConfig(cfg),
+		resourceGroupsClient: resourcegroups.NewFromConfig(cfg),
+		pollInterval:         d.Get("poll_interval").(int),
 	}, nil
 }
 
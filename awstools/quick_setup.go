@@ -0,0 +1,122 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
+	quicksetuptypes "github.com/aws/aws-sdk-go-v2/service/ssmquicksetup/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default time in seconds to wait for Quick Setup deployment
+const quickSetupWaitTimeout = 1800
+
+// Retrieves Quick Setup configuration manager by ARN.
+// Returns nil when the configuration manager does not exist.
+func (clients AwsClients) getConfigurationManager(ctx context.Context, managerArn string) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	output, err := clients.quickSetupClient.GetConfigurationManager(ctx, &ssmquicksetup.GetConfigurationManagerInput{
+		ManagerArn: &managerArn,
+	})
+
+	var notFound *quicksetuptypes.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// Retrieves the deployment status summary of Quick Setup configuration manager.
+func getDeploymentStatus(manager *ssmquicksetup.GetConfigurationManagerOutput) quicksetuptypes.StatusSummary {
+	for _, summary := range manager.StatusSummaries {
+		if summary.StatusType == quicksetuptypes.StatusTypeDeployment {
+			return summary
+		}
+	}
+
+	return quicksetuptypes.StatusSummary{}
+}
+
+// Wait until the deployment of Quick Setup configuration manager succeeds
+func (clients AwsClients) waitForConfigurationManagerDeployed(ctx context.Context, managerArn string, waitTimeout int) (*ssmquicksetup.GetConfigurationManagerOutput, error) {
+	deadline := time.Now().Add(time.Duration(waitTimeout) * time.Second)
+
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		manager, err := clients.getConfigurationManager(ctx, managerArn)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return nil, err
+		}
+
+		if manager == nil {
+			return nil, fmt.Errorf("configuration manager %s not found", managerArn)
+		}
+
+		status := getDeploymentStatus(manager)
+
+		switch status.Status {
+		case quicksetuptypes.StatusSucceeded:
+			return manager, nil
+		case quicksetuptypes.StatusFailed, quicksetuptypes.StatusStopped, quicksetuptypes.StatusStopFailed:
+			return nil, fmt.Errorf("configuration manager %s deployment %s: %s", managerArn, status.Status, aws.ToString(status.StatusMessage))
+		}
+
+		log.Info(ctx, fmt.Sprintf("Configuration manager %s deployment status is %s.", managerArn, status.Status))
+	}
+
+	log.Error(ctx, "Configuration manager is not deployed.")
+
+	return nil, errors.New("configuration manager is not deployed")
+}
+
+// Adds the new and changed tags and removes the deleted tags of Quick Setup resource.
+func (clients AwsClients) updateQuickSetupTags(ctx context.Context, resourceArn string, oldTags map[string]interface{}, newTags map[string]interface{}) error {
+	var removedKeys []string
+
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+
+	if len(removedKeys) > 0 {
+		_, err := clients.quickSetupClient.UntagResource(ctx, &ssmquicksetup.UntagResourceInput{
+			ResourceArn: &resourceArn,
+			TagKeys:     removedKeys,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	changedTags := make(map[string]string)
+
+	for key, value := range newTags {
+		if oldValue, ok := oldTags[key]; !ok || oldValue != value {
+			changedTags[key] = value.(string)
+		}
+	}
+
+	if len(changedTags) > 0 {
+		_, err := clients.quickSetupClient.TagResource(ctx, &ssmquicksetup.TagResourceInput{
+			ResourceArn: &resourceArn,
+			Tags:        changedTags,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_activation resource
+const (
+	attIamRole             string = "iam_role"
+	attRegistrationLimit   string = "registration_limit"
+	attRegistrationsCount  string = "registrations_count"
+	attExpirationDate      string = "expiration_date"
+	attExpired             string = "expired"
+	attDefaultInstanceName string = "default_instance_name"
+	attActivationId        string = "activation_id"
+	attActivationCode      string = "activation_code"
+)
+
+// Retrieves SSM activation by Id.
+func (clients AwsClients) getActivation(ctx context.Context, activationId string) (ssmtypes.Activation, error) {
+	output, err := clients.ssmClient.DescribeActivations(ctx, &ssm.DescribeActivationsInput{
+		Filters: []ssmtypes.DescribeActivationsFilter{
+			{
+				FilterKey:    ssmtypes.DescribeActivationsFilterKeysActivationIds,
+				FilterValues: []string{activationId},
+			},
+		},
+	})
+
+	if err != nil {
+		return ssmtypes.Activation{}, err
+	}
+
+	if len(output.ActivationList) == 0 {
+		return ssmtypes.Activation{}, nil
+	}
+
+	return output.ActivationList[0], nil
+}
+
+func resourceActivationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreateActivationInput{
+		IamRole:           aws.String(d.Get(attIamRole).(string)),
+		RegistrationLimit: aws.Int32(int32(d.Get(attRegistrationLimit).(int))),
+		Tags:              expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDefaultInstanceName); ok {
+		input.DefaultInstanceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attExpirationDate); ok {
+		expirationDate, err := time.Parse(time.RFC3339, v.(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.ExpirationDate = &expirationDate
+	}
+
+	output, err := awsClients.ssmClient.CreateActivation(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.ActivationId)
+
+	// The activation code is only returned when the activation is created.
+	if err := d.Set(attActivationCode, output.ActivationCode); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceActivationRead(ctx, d, m)
+}
+
+func resourceActivationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	activation, err := awsClients.getActivation(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if activation.ActivationId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	var expirationDate string
+	if activation.ExpirationDate != nil {
+		expirationDate = activation.ExpirationDate.UTC().Format(time.RFC3339)
+	}
+
+	values := map[string]interface{}{
+		attActivationId:        activation.ActivationId,
+		attIamRole:             activation.IamRole,
+		attDescription:         activation.Description,
+		attDefaultInstanceName: activation.DefaultInstanceName,
+		attRegistrationLimit:   activation.RegistrationLimit,
+		attRegistrationsCount:  activation.RegistrationsCount,
+		attExpirationDate:      expirationDate,
+		attExpired:             activation.Expired,
+		attTags:                flattenTags(activation.Tags),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	activationId := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteActivation(ctx, &ssm.DeleteActivationInput{
+		ActivationId: &activationId,
+	})
+
+	var notFound *ssmtypes.InvalidActivation
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceActivation() *schema.Resource {
+	tags := tagsSchema()
+	tags.ForceNew = true
+
+	return &schema.Resource{
+		CreateContext: resourceActivationCreate,
+		ReadContext:   resourceActivationRead,
+		DeleteContext: resourceActivationDelete,
+		Schema: map[string]*schema.Schema{
+			attIamRole: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			attDefaultInstanceName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			attRegistrationLimit: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			attExpirationDate: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			attTags: tags,
+			attActivationId: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			attActivationCode: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			attRegistrationsCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attExpired: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
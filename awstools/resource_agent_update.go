@@ -0,0 +1,225 @@
+package awstools
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_agent_update resource
+const (
+	attAllowDowngrade string = "allow_downgrade"
+	attAgentVersions  string = "agent_versions"
+)
+
+var ssmDocumentUpdateSSMAgent = "AWS-UpdateSSMAgent"
+
+// AWS-UpdateSSMAgent parameters
+var ssmAgentParameterVersion = "version"
+var ssmAgentParameterAllowDowngrade = "allowDowngrade"
+
+func setAgentUpdate(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	instanceIds, err := awsClients.listCommandInstanceIds(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instances, err := awsClients.listInstanceInformation(ctx, instanceIds)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agentVersions := make(map[string]string)
+	for _, instance := range instances {
+		agentVersions[aws.ToString(instance.InstanceId)] = aws.ToString(instance.AgentVersion)
+	}
+
+	values := map[string]interface{}{
+		attStatus:        command.Status,
+		attRequestedTime: command.RequestedDateTime.UTC().Format(time.RFC3339),
+		attAgentVersions: agentVersions,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Updates SSM Agent of the target instances.
+// Waits for the agents to report back online at the expected version.
+func resourceAgentUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	version := d.Get(attVersion).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		ssmAgentParameterAllowDowngrade: {strconv.FormatBool(d.Get(attAllowDowngrade).(bool))},
+	}
+
+	if version != "" {
+		ssmParameters[ssmAgentParameterVersion] = []string{version}
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+waitTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentUpdateSSMAgent, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	instanceIds, err := awsClients.listCommandInstanceIds(extendedCtx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := awsClients.waitForAgentVersion(extendedCtx, instanceIds, version, waitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAgentUpdate(ctx, awsClients, d, command)
+}
+
+func resourceAgentUpdateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setAgentUpdate(ctx, awsClients, d, command)
+}
+
+func resourceAgentUpdateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceAgentUpdateCreate(ctx, d, m)
+}
+
+func resourceAgentUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAgentUpdate() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceAgentUpdateCreate,
+		ReadContext:   resourceAgentUpdateRead,
+		UpdateContext: resourceAgentUpdateUpdate,
+		DeleteContext: resourceAgentUpdateDelete,
+		Schema: map[string]*schema.Schema{
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attAllowDowngrade: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1800,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attAgentVersions: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
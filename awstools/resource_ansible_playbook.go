@@ -0,0 +1,303 @@
+package awstools
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_ansible_playbook resource
+const (
+	attSourceType          string = "source_type"
+	attSourceInfo          string = "source_info"
+	attPlaybookFile        string = "playbook_file"
+	attExtraVars           string = "extra_vars"
+	attVerbosity           string = "verbosity"
+	attInstallDependencies string = "install_dependencies"
+	attPlayRecap           string = "play_recap"
+)
+
+var ssmDocumentApplyAnsiblePlaybooks = "AWS-ApplyAnsiblePlaybooks"
+
+// AWS-ApplyAnsiblePlaybooks parameters
+var ansibleParameterSourceType = "SourceType"
+var ansibleParameterSourceInfo = "SourceInfo"
+var ansibleParameterInstallDependencies = "InstallDependencies"
+var ansibleParameterPlaybookFile = "PlaybookFile"
+var ansibleParameterExtraVariables = "ExtraVariables"
+var ansibleParameterCheck = "Check"
+var ansibleParameterVerbose = "Verbose"
+var ansibleParameterTimeoutSeconds = "TimeoutSeconds"
+
+// Counters of the PLAY RECAP lines, e.g. "web1 : ok=3 changed=1 unreachable=0 failed=0 skipped=2 rescued=0 ignored=0"
+var ansibleRecapCounterRegexp = regexache.MustCompile(`\b(ok|changed|unreachable|failed|skipped|rescued|ignored)=(\d+)`)
+var ansibleRecapLineRegexp = regexache.MustCompile(`(?m)^\S+\s+:\s+ok=\d+.*$`)
+
+// Joins the extra variables into space separated key=value pairs.
+func getAnsibleExtraVariables(d *schema.ResourceData) string {
+	extraVars := d.Get(attExtraVars).(map[string]interface{})
+
+	if len(extraVars) == 0 {
+		return "SSM=True"
+	}
+
+	var names []string
+	for name := range extraVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pairs []string
+	for _, name := range names {
+		pairs = append(pairs, name+"="+extraVars[name].(string))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// Sums the counters of the PLAY RECAP lines of all the command outputs.
+func parseAnsiblePlayRecap(outputs map[string]string) map[string]int {
+	recap := map[string]int{
+		"ok":          0,
+		"changed":     0,
+		"unreachable": 0,
+		"failed":      0,
+		"skipped":     0,
+		"rescued":     0,
+		"ignored":     0,
+	}
+
+	for _, output := range outputs {
+		for _, line := range ansibleRecapLineRegexp.FindAllString(output, -1) {
+			for _, match := range ansibleRecapCounterRegexp.FindAllStringSubmatch(line, -1) {
+				count, err := strconv.Atoi(match[2])
+				if err == nil {
+					recap[match[1]] += count
+				}
+			}
+		}
+	}
+
+	return recap
+}
+
+func setAnsiblePlaybook(d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	values := map[string]interface{}{
+		attStatus:        command.Status,
+		attRequestedTime: command.RequestedDateTime.UTC().Format(time.RFC3339),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Runs the playbook on the target instances.
+// Parses the play recap from the outputs of the command invocations.
+func resourceAnsiblePlaybookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		ansibleParameterSourceType:          {d.Get(attSourceType).(string)},
+		ansibleParameterSourceInfo:          {d.Get(attSourceInfo).(string)},
+		ansibleParameterInstallDependencies: {documentBool(d.Get(attInstallDependencies).(bool))},
+		ansibleParameterPlaybookFile:        {d.Get(attPlaybookFile).(string)},
+		ansibleParameterExtraVariables:      {getAnsibleExtraVariables(d)},
+		ansibleParameterCheck:               {documentBool(d.Get(attCheck).(bool))},
+		ansibleParameterVerbose:             {"-" + strings.Repeat("v", d.Get(attVerbosity).(int))},
+		ansibleParameterTimeoutSeconds:      {strconv.Itoa(executionTimeout)},
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentApplyAnsiblePlaybooks, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	outputs, err := awsClients.listCommandOutputs(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attPlayRecap, parseAnsiblePlayRecap(outputs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAnsiblePlaybook(d, command)
+}
+
+func resourceAnsiblePlaybookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setAnsiblePlaybook(d, command)
+}
+
+func resourceAnsiblePlaybookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceAnsiblePlaybookCreate(ctx, d, m)
+}
+
+func resourceAnsiblePlaybookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAnsiblePlaybook() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceAnsiblePlaybookCreate,
+		ReadContext:   resourceAnsiblePlaybookRead,
+		UpdateContext: resourceAnsiblePlaybookUpdate,
+		DeleteContext: resourceAnsiblePlaybookDelete,
+		Schema: map[string]*schema.Schema{
+			attSourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"S3", "GitHub"}, false),
+			},
+			attSourceInfo: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			attPlaybookFile: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attExtraVars: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attCheck: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attVerbosity: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+			attInstallDependencies: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlayRecap: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,361 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_association resource
+const (
+	attAssociationName         string = "association_name"
+	attAssociationId           string = "association_id"
+	attDocumentVersion         string = "document_version"
+	attScheduleExpression      string = "schedule_expression"
+	attComplianceSeverity      string = "compliance_severity"
+	attMaxConcurrency          string = "max_concurrency"
+	attMaxErrors               string = "max_errors"
+	attApplyOnlyAtCronInterval string = "apply_only_at_cron_interval"
+	attS3Region                string = "s3_region"
+)
+
+func getAssociationOutputLocation(d *schema.ResourceData) *ssmtypes.InstanceAssociationOutputLocation {
+	outputLocation := getOutputLocation(d)
+
+	if outputLocation.s3Bucket == nil {
+		return nil
+	}
+
+	s3Location := &ssmtypes.S3OutputLocation{
+		OutputS3BucketName: outputLocation.s3Bucket,
+		OutputS3KeyPrefix:  outputLocation.s3KeyPrefix,
+	}
+
+	if v, ok := d.GetOk(attOutputLocation + ".0." + attS3Region); ok {
+		s3Location.OutputS3Region = aws.String(v.(string))
+	}
+
+	return &ssmtypes.InstanceAssociationOutputLocation{S3Location: s3Location}
+}
+
+func flattenAssociationOutputLocation(outputLocation *ssmtypes.InstanceAssociationOutputLocation) []interface{} {
+	if outputLocation == nil || outputLocation.S3Location == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			attS3BucketName: aws.ToString(outputLocation.S3Location.OutputS3BucketName),
+			attS3KeyPrefix:  aws.ToString(outputLocation.S3Location.OutputS3KeyPrefix),
+			attS3Region:     aws.ToString(outputLocation.S3Location.OutputS3Region),
+		},
+	}
+}
+
+func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreateAssociationInput{
+		Name:                    aws.String(d.Get(attDocumentName).(string)),
+		Parameters:              getParameters(d, attParameters),
+		Targets:                 getTargets(d),
+		ComplianceSeverity:      ssmtypes.AssociationComplianceSeverity(d.Get(attComplianceSeverity).(string)),
+		OutputLocation:          getAssociationOutputLocation(d),
+		ApplyOnlyAtCronInterval: d.Get(attApplyOnlyAtCronInterval).(bool),
+		Tags:                    expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attAssociationName); ok {
+		input.AssociationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attScheduleExpression); ok {
+		input.ScheduleExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.CreateAssociation(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.AssociationDescription.AssociationId)
+
+	return resourceAssociationRead(ctx, d, m)
+}
+
+func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	associationId := d.Id()
+
+	output, err := awsClients.ssmClient.DescribeAssociation(ctx, &ssm.DescribeAssociationInput{
+		AssociationId: &associationId,
+	})
+
+	var notFound *ssmtypes.AssociationDoesNotExist
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association := output.AssociationDescription
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingAssociation, associationId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attAssociationId:           association.AssociationId,
+		attAssociationName:         association.AssociationName,
+		attDocumentName:            association.Name,
+		attDocumentVersion:         association.DocumentVersion,
+		attParameters:              flattenParameters(d, attParameters, association.Parameters),
+		attTargets:                 flattenTargets(association.Targets),
+		attScheduleExpression:      association.ScheduleExpression,
+		attComplianceSeverity:      association.ComplianceSeverity,
+		attMaxConcurrency:          association.MaxConcurrency,
+		attMaxErrors:               association.MaxErrors,
+		attOutputLocation:          flattenAssociationOutputLocation(association.OutputLocation),
+		attApplyOnlyAtCronInterval: association.ApplyOnlyAtCronInterval,
+		attTags:                    tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	associationId := d.Id()
+
+	if d.HasChangesExcept(attTags) {
+		input := &ssm.UpdateAssociationInput{
+			AssociationId:           &associationId,
+			Name:                    aws.String(d.Get(attDocumentName).(string)),
+			Parameters:              getParameters(d, attParameters),
+			Targets:                 getTargets(d),
+			ComplianceSeverity:      ssmtypes.AssociationComplianceSeverity(d.Get(attComplianceSeverity).(string)),
+			OutputLocation:          getAssociationOutputLocation(d),
+			ApplyOnlyAtCronInterval: d.Get(attApplyOnlyAtCronInterval).(bool),
+		}
+
+		if v, ok := d.GetOk(attAssociationName); ok {
+			input.AssociationName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attDocumentVersion); ok {
+			input.DocumentVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attScheduleExpression); ok {
+			input.ScheduleExpression = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attMaxConcurrency); ok {
+			input.MaxConcurrency = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attMaxErrors); ok {
+			input.MaxErrors = aws.String(v.(string))
+		}
+
+		_, err := awsClients.ssmClient.UpdateAssociation(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingAssociation, associationId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAssociationRead(ctx, d, m)
+}
+
+func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	associationId := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteAssociation(ctx, &ssm.DeleteAssociationInput{
+		AssociationId: &associationId,
+	})
+
+	var notFound *ssmtypes.AssociationDoesNotExist
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAssociationCreate,
+		ReadContext:   resourceAssociationRead,
+		UpdateContext: resourceAssociationUpdate,
+		DeleteContext: resourceAssociationDelete,
+		Schema: map[string]*schema.Schema{
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attAssociationName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attScheduleExpression: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attComplianceSeverity: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.AssociationComplianceSeverity("").Values()), false),
+			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						attS3Region: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			attApplyOnlyAtCronInterval: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTags: tagsSchema(),
+			attAssociationId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,281 @@
+package awstools
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_automation_execution resource
+const (
+	attMode                string = "mode"
+	attTargetParameterName string = "target_parameter_name"
+	attWaitForCompletion   string = "wait_for_completion"
+	attOutputs             string = "outputs"
+	attFailureMessage      string = "failure_message"
+)
+
+func flattenAutomationOutputs(outputs map[string][]string) []interface{} {
+	var blocks []interface{}
+
+	for _, name := range sortedKeys(outputs) {
+		blocks = append(blocks, map[string]interface{}{
+			attName:   name,
+			attValues: outputs[name],
+		})
+	}
+
+	return blocks
+}
+
+func setAutomationExecution(d *schema.ResourceData, execution ssmtypes.AutomationExecution) diag.Diagnostics {
+	values := map[string]interface{}{
+		attStatus:         execution.AutomationExecutionStatus,
+		attOutputs:        flattenAutomationOutputs(execution.Outputs),
+		attFailureMessage: execution.FailureMessage,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAutomationExecutionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	wait := d.Get(attWaitForCompletion).(bool)
+
+	input := &ssm.StartAutomationExecutionInput{
+		DocumentName: aws.String(d.Get(attDocumentName).(string)),
+		Parameters:   getParameters(d, attParameters),
+		Mode:         ssmtypes.ExecutionMode(d.Get(attMode).(string)),
+		Targets:      getTargets(d),
+		Tags:         expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attTargetParameterName); ok {
+		input.TargetParameterName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	execution, err := awsClients.RunAutomation(extendedCtx, input, wait, executionTimeout)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*execution.AutomationExecutionId)
+
+	return setAutomationExecution(d, execution)
+}
+
+func resourceAutomationExecutionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	execution, err := awsClients.GetAutomationExecution(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if execution.AutomationExecutionId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setAutomationExecution(d, execution)
+}
+
+func resourceAutomationExecutionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceAutomationExecutionCreate(ctx, d, m)
+}
+
+// Stops the automation execution if it is still running.
+func resourceAutomationExecutionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionId := d.Id()
+
+	execution, err := awsClients.GetAutomationExecution(ctx, executionId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch execution.AutomationExecutionStatus {
+	case "Pending", "InProgress", "Waiting", "PendingApproval", "Approved", "Scheduled", "RunbookInProgress":
+		_, err := awsClients.ssmClient.StopAutomationExecution(ctx, &ssm.StopAutomationExecutionInput{
+			AutomationExecutionId: &executionId,
+			Type:                  ssmtypes.StopTypeCancel,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAutomationExecution() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceAutomationExecutionCreate,
+		ReadContext:   resourceAutomationExecutionRead,
+		UpdateContext: resourceAutomationExecutionUpdate,
+		DeleteContext: resourceAutomationExecutionDelete,
+		Schema: map[string]*schema.Schema{
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.ExecutionModeAuto),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ExecutionMode("").Values()), false),
+			},
+			attTargetParameterName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attTags: tagsSchema(),
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attFailureMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attOutputs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,206 @@
+package awstools
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_automation_signal resource
+const (
+	attAutomationExecutionId string = "automation_execution_id"
+	attSignalType            string = "signal_type"
+	attStepName              string = "step_name"
+	attStepExecutionId       string = "step_execution_id"
+)
+
+// SendAutomationSignal payload keys
+var ssmSignalPayloadComment = "Comment"
+var ssmSignalPayloadStepName = "StepName"
+var ssmSignalPayloadStepExecutionId = "StepExecutionId"
+
+// Builds the signal payload expected by the signal type.
+func getSignalPayload(d *schema.ResourceData, signalType ssmtypes.SignalType) (map[string][]string, diag.Diagnostics) {
+	switch signalType {
+	case ssmtypes.SignalTypeApprove, ssmtypes.SignalTypeReject:
+		if v, ok := d.GetOk(attComment); ok {
+			return map[string][]string{ssmSignalPayloadComment: {v.(string)}}, nil
+		}
+
+		return nil, nil
+	case ssmtypes.SignalTypeStartStep, ssmtypes.SignalTypeResume:
+		v, ok := d.GetOk(attStepName)
+		if !ok {
+			return nil, diag.Errorf("%s is required for %s signal", attStepName, signalType)
+		}
+
+		return map[string][]string{ssmSignalPayloadStepName: {v.(string)}}, nil
+	case ssmtypes.SignalTypeStopStep:
+		v, ok := d.GetOk(attStepExecutionId)
+		if !ok {
+			return nil, diag.Errorf("%s is required for %s signal", attStepExecutionId, signalType)
+		}
+
+		return map[string][]string{ssmSignalPayloadStepExecutionId: {v.(string)}}, nil
+	}
+
+	return nil, nil
+}
+
+func setAutomationSignal(d *schema.ResourceData, execution ssmtypes.AutomationExecution) diag.Diagnostics {
+	values := map[string]interface{}{
+		attStatus:         execution.AutomationExecutionStatus,
+		attFailureMessage: execution.FailureMessage,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Sends the signal to the automation execution.
+// Rejected executions never complete successfully, so the resource does not wait for them.
+func resourceAutomationSignalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionId := d.Get(attAutomationExecutionId).(string)
+	signalType := ssmtypes.SignalType(d.Get(attSignalType).(string))
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	wait := d.Get(attWaitForCompletion).(bool) && signalType != ssmtypes.SignalTypeReject
+
+	payload, diags := getSignalPayload(d, signalType)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	execution, err := awsClients.SendAutomationSignal(extendedCtx, &ssm.SendAutomationSignalInput{
+		AutomationExecutionId: aws.String(executionId),
+		SignalType:            signalType,
+		Payload:               payload,
+	}, wait, executionTimeout)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(executionId + "/" + string(signalType))
+
+	return setAutomationSignal(d, execution)
+}
+
+func resourceAutomationSignalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	execution, err := awsClients.GetAutomationExecution(ctx, ids[0])
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if execution.AutomationExecutionId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setAutomationSignal(d, execution)
+}
+
+func resourceAutomationSignalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceAutomationSignalCreate(ctx, d, m)
+}
+
+// Signals cannot be withdrawn, the resource is only removed from the state.
+func resourceAutomationSignalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAutomationSignal() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceAutomationSignalCreate,
+		ReadContext:   resourceAutomationSignalRead,
+		UpdateContext: resourceAutomationSignalUpdate,
+		DeleteContext: resourceAutomationSignalDelete,
+		Schema: map[string]*schema.Schema{
+			attAutomationExecutionId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attSignalType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.SignalType("").Values()), false),
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attStepName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attStepExecutionId: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attFailureMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,211 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_change_calendar resource
+const (
+	attNextTransitionTime string = "next_transition_time"
+)
+
+func resourceChangeCalendarCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	_, err := awsClients.ssmClient.CreateDocument(ctx, &ssm.CreateDocumentInput{
+		Name:           &name,
+		Content:        aws.String(d.Get(attContent).(string)),
+		DocumentType:   ssmtypes.DocumentTypeChangeCalendar,
+		DocumentFormat: ssmtypes.DocumentFormatText,
+		Tags:           expandTags(d.Get(attTags).(map[string]interface{})),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceChangeCalendarRead(ctx, d, m)
+}
+
+// Reads the calendar content and the current state of the calendar.
+func resourceChangeCalendarRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	document, err := awsClients.GetDocument(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, &ssm.GetDocumentInput{
+		Name:           document.Name,
+		DocumentFormat: ssmtypes.DocumentFormatText,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state, err := awsClients.getCalendarState(ctx, []string{d.Id()})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:               document.Name,
+		attContent:            output.Content,
+		attLatestVersion:      document.LatestVersion,
+		attDefaultVersion:     document.DefaultVersion,
+		attTags:               flattenTags(document.Tags),
+		attState:              state.State,
+		attNextTransitionTime: state.NextTransitionTime,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceChangeCalendarUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	if d.HasChange(attContent) {
+		output, err := awsClients.ssmClient.UpdateDocument(ctx, &ssm.UpdateDocumentInput{
+			Name:            &name,
+			Content:         aws.String(d.Get(attContent).(string)),
+			DocumentFormat:  ssmtypes.DocumentFormatText,
+			DocumentVersion: aws.String("$LATEST"),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = awsClients.ssmClient.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+			Name:            &name,
+			DocumentVersion: output.DocumentDescription.DocumentVersion,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingDocument, name, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceChangeCalendarRead(ctx, d, m)
+}
+
+func resourceChangeCalendarDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceChangeCalendar() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChangeCalendarCreate,
+		ReadContext:   resourceChangeCalendarRead,
+		UpdateContext: resourceChangeCalendarUpdate,
+		DeleteContext: resourceChangeCalendarDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attContent: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attTags: tagsSchema(),
+			attLatestVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDefaultVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attNextTransitionTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
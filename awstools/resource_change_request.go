@@ -0,0 +1,304 @@
+package awstools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_change_request resource
+const (
+	attChangeRequestName string = "change_request_name"
+	attRunbook           string = "runbook"
+	attScheduledTime     string = "scheduled_time"
+	attScheduledEndTime  string = "scheduled_end_time"
+	attAutoApprove       string = "auto_approve"
+	attChangeDetails     string = "change_details"
+)
+
+func expandRunbooks(d *schema.ResourceData) []ssmtypes.Runbook {
+	var runbooks []ssmtypes.Runbook
+
+	for i, r := range d.Get(attRunbook).([]interface{}) {
+		block := r.(map[string]interface{})
+		prefix := fmt.Sprintf("%s.%d.", attRunbook, i)
+
+		runbook := ssmtypes.Runbook{
+			DocumentName: aws.String(block[attDocumentName].(string)),
+			Parameters:   getParameters(d, prefix+attParameters),
+		}
+
+		for _, t := range block[attTargets].([]interface{}) {
+			target := t.(map[string]interface{})
+			key := target[attKey].(string)
+			var values []string
+			for _, value := range target[attValues].([]interface{}) {
+				values = append(values, value.(string))
+			}
+			runbook.Targets = append(runbook.Targets, ssmtypes.Target{Key: &key, Values: values})
+		}
+
+		if v := block[attDocumentVersion].(string); v != "" {
+			runbook.DocumentVersion = aws.String(v)
+		}
+
+		if v := block[attTargetParameterName].(string); v != "" {
+			runbook.TargetParameterName = aws.String(v)
+		}
+
+		if v := block[attMaxConcurrency].(string); v != "" {
+			runbook.MaxConcurrency = aws.String(v)
+		}
+
+		if v := block[attMaxErrors].(string); v != "" {
+			runbook.MaxErrors = aws.String(v)
+		}
+
+		runbooks = append(runbooks, runbook)
+	}
+
+	return runbooks
+}
+
+// Starts the change request and waits for the approval and the execution of the runbooks.
+func resourceChangeRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	wait := d.Get(attWaitForCompletion).(bool)
+
+	input := &ssm.StartChangeRequestExecutionInput{
+		DocumentName: aws.String(d.Get(attDocumentName).(string)),
+		Parameters:   getParameters(d, attParameters),
+		Runbooks:     expandRunbooks(d),
+		AutoApprove:  d.Get(attAutoApprove).(bool),
+		Tags:         expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attChangeRequestName); ok {
+		input.ChangeRequestName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attChangeDetails); ok {
+		input.ChangeDetails = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attScheduledTime); ok {
+		scheduledTime, _ := time.Parse(time.RFC3339, v.(string))
+		input.ScheduledTime = &scheduledTime
+	}
+
+	if v, ok := d.GetOk(attScheduledEndTime); ok {
+		scheduledEndTime, _ := time.Parse(time.RFC3339, v.(string))
+		input.ScheduledEndTime = &scheduledEndTime
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	execution, err := awsClients.StartChangeRequest(extendedCtx, input, wait, executionTimeout)
+
+	if execution.AutomationExecutionId != nil {
+		d.SetId(*execution.AutomationExecutionId)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setAutomationExecution(d, execution)
+}
+
+func resourceChangeRequestUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceChangeRequestCreate(ctx, d, m)
+}
+
+func resourceChangeRequest() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceChangeRequestCreate,
+		ReadContext:   resourceAutomationExecutionRead,
+		UpdateContext: resourceChangeRequestUpdate,
+		DeleteContext: resourceAutomationExecutionDelete,
+		Schema: map[string]*schema.Schema{
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attChangeRequestName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attRunbook: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attDocumentName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attDocumentVersion: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attParameters: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									attValues: {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						attTargetParameterName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attTargets: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attKey: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									attValues: {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						attMaxConcurrency: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attMaxErrors: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			attScheduledTime: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attScheduledEndTime: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			attAutoApprove: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attChangeDetails: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attTags: tagsSchema(),
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attFailureMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attOutputs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
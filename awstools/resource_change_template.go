@@ -0,0 +1,184 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_change_template resource
+const (
+	attReviewStatus string = "review_status"
+)
+
+func resourceChangeTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	_, err := awsClients.ssmClient.CreateDocument(ctx, &ssm.CreateDocumentInput{
+		Name:           &name,
+		Content:        aws.String(d.Get(attContent).(string)),
+		DocumentType:   ssmtypes.DocumentTypeChangeTemplate,
+		DocumentFormat: ssmtypes.DocumentFormat(d.Get(attDocumentFormat).(string)),
+		Tags:           expandTags(d.Get(attTags).(map[string]interface{})),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceChangeTemplateRead(ctx, d, m)
+}
+
+func resourceChangeTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	document, err := awsClients.GetDocument(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, &ssm.GetDocumentInput{
+		Name:           document.Name,
+		DocumentFormat: document.DocumentFormat,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:           document.Name,
+		attContent:        output.Content,
+		attDocumentFormat: document.DocumentFormat,
+		attLatestVersion:  document.LatestVersion,
+		attDefaultVersion: document.DefaultVersion,
+		attReviewStatus:   document.ReviewStatus,
+		attTags:           flattenTags(document.Tags),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceChangeTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	if d.HasChanges(attContent, attDocumentFormat) {
+		output, err := awsClients.ssmClient.UpdateDocument(ctx, &ssm.UpdateDocumentInput{
+			Name:            &name,
+			Content:         aws.String(d.Get(attContent).(string)),
+			DocumentFormat:  ssmtypes.DocumentFormat(d.Get(attDocumentFormat).(string)),
+			DocumentVersion: aws.String("$LATEST"),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = awsClients.ssmClient.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+			Name:            &name,
+			DocumentVersion: output.DocumentDescription.DocumentVersion,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingDocument, name, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceChangeTemplateRead(ctx, d, m)
+}
+
+func resourceChangeTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceChangeTemplateCreate,
+		ReadContext:   resourceChangeTemplateRead,
+		UpdateContext: resourceChangeTemplateUpdate,
+		DeleteContext: resourceDocumentDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attContent: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			attDocumentFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.DocumentFormatJson),
+				ValidateFunc: validation.StringInSlice([]string{string(ssmtypes.DocumentFormatJson), string(ssmtypes.DocumentFormatYaml)}, false),
+			},
+			attTags: tagsSchema(),
+			attLatestVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDefaultVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attReviewStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
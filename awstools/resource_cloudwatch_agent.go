@@ -0,0 +1,333 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_cloudwatch_agent resource
+const (
+	attConfigParameterName string = "config_parameter_name"
+	attConfigJson          string = "config_json"
+	attAgentMode           string = "agent_mode"
+	attInstall             string = "install"
+	attStopOnDestroy       string = "stop_on_destroy"
+	attAgentStatuses       string = "agent_statuses"
+)
+
+var ssmDocumentManageCloudWatchAgent = "AmazonCloudWatch-ManageAgent"
+
+var cloudWatchAgentPackageName = "AmazonCloudWatchAgent"
+
+// AmazonCloudWatch-ManageAgent parameters
+var cloudWatchAgentParameterAction = "action"
+var cloudWatchAgentParameterMode = "mode"
+var cloudWatchAgentParameterConfigurationSource = "optionalConfigurationSource"
+var cloudWatchAgentParameterConfigurationLocation = "optionalConfigurationLocation"
+var cloudWatchAgentParameterRestart = "optionalRestart"
+
+// Agent status reported by amazon-cloudwatch-agent-ctl, e.g. {"status": "running", "configstatus": "configured", ...}
+var cloudWatchAgentStatusRegexp = regexache.MustCompile(`"status"\s*:\s*"(\w+)"`)
+var cloudWatchAgentConfigStatusRegexp = regexache.MustCompile(`"configstatus"\s*:\s*"(\w+)"`)
+
+// Runs AmazonCloudWatch-ManageAgent document with the action on the target instances.
+func runCloudWatchAgentCommand(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, ssmParameters map[string][]string) (ssmtypes.Command, error) {
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	return awsClients.RunCommand(extendedCtx, &ssmDocumentManageCloudWatchAgent, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+}
+
+// Parses the agent statuses from the outputs of the status command.
+// The agent is reported as running only if it runs with a configuration.
+func parseCloudWatchAgentStatuses(outputs map[string]string) map[string]string {
+	statuses := make(map[string]string)
+
+	for instanceId, output := range outputs {
+		status := "unknown"
+
+		if match := cloudWatchAgentStatusRegexp.FindStringSubmatch(output); match != nil {
+			status = match[1]
+		}
+
+		if match := cloudWatchAgentConfigStatusRegexp.FindStringSubmatch(output); match != nil && status == "running" && match[1] != "configured" {
+			status = match[1]
+		}
+
+		statuses[instanceId] = status
+	}
+
+	return statuses
+}
+
+// Installs the agent if requested, stores the inline configuration and configures the agent with it.
+func resourceCloudWatchAgentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	parameterName := d.Get(attConfigParameterName).(string)
+
+	if configJson := d.Get(attConfigJson).(string); configJson != "" {
+		_, err := awsClients.ssmClient.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:      aws.String(parameterName),
+			Value:     aws.String(configJson),
+			Type:      ssmtypes.ParameterTypeString,
+			Tier:      ssmtypes.ParameterTierIntelligentTiering,
+			Overwrite: aws.Bool(true),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get(attInstall).(bool) {
+		executionTimeout := d.Get(attExecutionTimeout).(int)
+		comment := d.Get(attComment).(string)
+		outputLocation := getOutputLocation(d)
+
+		ssmParameters := map[string][]string{
+			packageParameterAction: {"Install"},
+			packageParameterName:   {cloudWatchAgentPackageName},
+		}
+
+		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		defer cancel()
+
+		_, err := awsClients.RunCommand(extendedCtx, &ssmDocumentConfigureAWSPackage, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	ssmParameters := map[string][]string{
+		cloudWatchAgentParameterAction:              {"configure"},
+		cloudWatchAgentParameterMode:                {d.Get(attAgentMode).(string)},
+		cloudWatchAgentParameterConfigurationSource: {"default"},
+		cloudWatchAgentParameterRestart:             {"yes"},
+	}
+
+	if parameterName != "" {
+		ssmParameters[cloudWatchAgentParameterConfigurationSource] = []string{"ssm"}
+		ssmParameters[cloudWatchAgentParameterConfigurationLocation] = []string{parameterName}
+	}
+
+	command, err := runCloudWatchAgentCommand(ctx, awsClients, d, ssmParameters)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	diags := resourceCloudWatchAgentRead(ctx, d, m)
+
+	if !diags.HasError() && d.Id() == "" {
+		return diag.Errorf("CloudWatch agent is not running after configure command %s", *command.CommandId)
+	}
+
+	return diags
+}
+
+// Queries the agent status on the target instances.
+// The resource is removed from the state if the agent is not running on any instance, so that the next apply configures it again.
+func resourceCloudWatchAgentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ssmParameters := map[string][]string{
+		cloudWatchAgentParameterAction: {"status"},
+		cloudWatchAgentParameterMode:   {d.Get(attAgentMode).(string)},
+	}
+
+	command, err := runCloudWatchAgentCommand(ctx, awsClients, d, ssmParameters)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	outputs, err := awsClients.listCommandOutputs(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statuses := parseCloudWatchAgentStatuses(outputs)
+
+	for instanceId, status := range statuses {
+		if status != "running" {
+			log.Info(ctx, fmt.Sprintf("CloudWatch agent is %s on instance %s.", status, instanceId))
+			d.SetId("")
+			return diags
+		}
+	}
+
+	if err := d.Set(attAgentStatuses, statuses); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCloudWatchAgentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceCloudWatchAgentCreate(ctx, d, m)
+}
+
+// Stops the agent if requested and deletes the parameter of the inline configuration.
+func resourceCloudWatchAgentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if d.Get(attStopOnDestroy).(bool) {
+		ssmParameters := map[string][]string{
+			cloudWatchAgentParameterAction: {"stop"},
+			cloudWatchAgentParameterMode:   {d.Get(attAgentMode).(string)},
+		}
+
+		if _, err := runCloudWatchAgentCommand(ctx, awsClients, d, ssmParameters); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get(attConfigJson).(string) != "" {
+		_, err := awsClients.ssmClient.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+			Name: aws.String(d.Get(attConfigParameterName).(string)),
+		})
+
+		var notFound *ssmtypes.ParameterNotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceCloudWatchAgent() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &checkTimeout,
+			Update:  &updateTimeout,
+			Delete:  &updateTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceCloudWatchAgentCreate,
+		ReadContext:   resourceCloudWatchAgentRead,
+		UpdateContext: resourceCloudWatchAgentUpdate,
+		DeleteContext: resourceCloudWatchAgentDelete,
+		Schema: map[string]*schema.Schema{
+			attConfigParameterName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			attConfigJson: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{attConfigParameterName},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			attAgentMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ec2",
+				ValidateFunc: validation.StringInSlice([]string{"ec2", "onPremise", "auto"}, false),
+			},
+			attInstall: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attStopOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attAgentStatuses: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
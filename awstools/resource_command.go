@@ -2,11 +2,25 @@ package awstools
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/go-cty/cty"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Resource timeouts
@@ -16,23 +30,114 @@ var updateTimeout time.Duration = time.Duration(24) * time.Hour
 var deleteTimeout time.Duration = time.Duration(60) * time.Second
 var defaultTimeout time.Duration = time.Duration(24) * time.Hour
 
+// SSM rejects the commands with a timeout below 30 seconds.
+var minCommandTimeout time.Duration = time.Duration(30) * time.Second
+
 // Attributes of ssm_command resource
 const (
-	attDocumentName        string = "document_name"
-	attParameters          string = "parameters"
-	attDestroyDocumentName string = "destroy_document_name"
-	attDestroyParameters   string = "destroy_parameters"
-	attTargets             string = "targets"
-	attExecutionTimeout    string = "execution_timeout"
-	attComment             string = "comment"
-	attOutputLocation      string = "output_location"
-	attS3BucketName        string = "s3_bucket_name"
-	attS3KeyPrefix         string = "s3_key_prefix"
-	attName                string = "name"
-	attKey                 string = "key"
-	attValues              string = "values"
-	attStatus              string = "status"
-	attRequestedTime       string = "requested_time"
+	attDocumentName            string = "document_name"
+	attParameters              string = "parameters"
+	attDestroyDocumentName     string = "destroy_document_name"
+	attDestroyParameters       string = "destroy_parameters"
+	attTargets                 string = "targets"
+	attExecutionTimeout        string = "execution_timeout"
+	attComment                 string = "comment"
+	attOutputLocation          string = "output_location"
+	attS3BucketName            string = "s3_bucket_name"
+	attS3KeyPrefix             string = "s3_key_prefix"
+	attName                    string = "name"
+	attKey                     string = "key"
+	attValues                  string = "values"
+	attStatus                  string = "status"
+	attRequestedTime           string = "requested_time"
+	attRespectChangeCalendar   string = "respect_change_calendar"
+	attChangeCalendarAction    string = "change_calendar_action"
+	attCloudWatchOutputConfig  string = "cloudwatch_output_config"
+	attCloudWatchOutputEnabled string = "cloudwatch_output_enabled"
+	attCloudWatchLogStreams    string = "cloudwatch_log_streams"
+	attNotificationConfig      string = "notification_config"
+	attNotificationArn         string = "notification_arn"
+	attNotificationEvents      string = "notification_events"
+	attNotificationType        string = "notification_type"
+	attAlarmConfiguration      string = "alarm_configuration"
+	attAlarm                   string = "alarm"
+	attIgnorePollAlarmFailure  string = "ignore_poll_alarm_failure"
+	attResolvedDocumentVersion string = "resolved_document_version"
+	attDocumentHash            string = "document_hash"
+	attDocumentHashType        string = "document_hash_type"
+	attCommandIds              string = "command_ids"
+	attPowerShell              string = "powershell"
+	attWorkingDirectory        string = "working_directory"
+	attStdout                  string = "stdout"
+	attStderr                  string = "stderr"
+	attOutputFormat            string = "output_format"
+	attOutputMap               string = "output_map"
+	attMaxOutputSize           string = "max_output_size"
+	attOutputFile              string = "output_file"
+	attOnDestroy               string = "on_destroy"
+	attIgnoreFailure           string = "ignore_failure"
+	attRerunOnChange           string = "rerun_on_change"
+	attRunOn                   string = "run_on"
+	attWaitForInstances        string = "wait_for_instances"
+	attInstanceOnlineTimeout   string = "instance_online_timeout"
+	attPollInterval            string = "poll_interval"
+	attRetry                   string = "retry"
+	attMaxAttempts             string = "max_attempts"
+	attRetryOn                 string = "retry_on"
+	attBackoff                 string = "backoff"
+)
+
+// Failures of the command to retry on
+const (
+	retryOnFailed            = "Failed"
+	retryOnTimedOut          = "TimedOut"
+	retryOnDeliveryTimedOut  = "DeliveryTimedOut"
+	retryOnExecutionTimedOut = "ExecutionTimedOut"
+	retryOnUndeliverable     = "Undeliverable"
+	retryOnCancelled         = "Cancelled"
+)
+
+var defaultRetryOn = []string{retryOnFailed, retryOnTimedOut, retryOnDeliveryTimedOut}
+
+// Applies on which the command is sent
+const (
+	runOnCreate     = "create"
+	runOnEveryApply = "every_apply"
+	runOnUpdateOnly = "update_only"
+)
+
+// Arguments of the command sent on the resource creation, the command is sent again when they change
+var commandInputKeys = []string{
+	attDocumentName, attCommands, attPowerShell, attDocumentVersion, attDocumentHash, attDocumentHashType,
+	attParameters, attTargets, attInstanceIds, attComment, attExecutionTimeout, attWorkingDirectory,
+	attOutputLocation, attCloudWatchOutputConfig, attNotificationConfig, attServiceRoleArn,
+	attAlarmConfiguration, attMaxConcurrency, attMaxErrors,
+}
+
+// Attributes of the command sent, they are unknown until the command is sent again
+var commandComputedKeys = []string{
+	attCommandId, attCommandIds, attStatus, attStatusDetails, attRequestedTime, attCompletedTime,
+	attTargetCount, attCompletedCount, attErrorCount, attDeliveryTimedOutCount,
+	attResolvedDocumentVersion, attInvocations, attCloudWatchLogStreams,
+}
+
+// Formats of the command outputs
+const (
+	outputFormatText = "text"
+	outputFormatJson = "json"
+)
+
+// Parameters of the run script documents
+var ssmParameterWorkingDirectory = "workingDirectory"
+var ssmParameterExecutionTimeout = "executionTimeout"
+
+// SendCommand accepts up to 50 instance Ids per command
+const maxCommandInstanceIds = 50
+
+// Document versions resolved by SSM
+const (
+	documentVersionLatest  = "$LATEST"
+	documentVersionDefault = "$DEFAULT"
 )
 
 type OutputLocation struct {
@@ -78,6 +183,49 @@ func getTargets(d *schema.ResourceData) []ssmtypes.Target {
 	return ssmTargets
 }
 
+// Converts SSM parameters to parameters blocks keeping the order of the blocks in the state.
+func flattenParameters(d *schema.ResourceData, parametersKey string, ssmParameters map[string][]string) []interface{} {
+	var parameters []interface{}
+	var names []string
+
+	for _, p := range d.Get(parametersKey).([]interface{}) {
+		name := p.(map[string]interface{})[attName].(string)
+		if _, ok := ssmParameters[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	var newNames []string
+	for name := range ssmParameters {
+		if !slices.Contains(names, name) {
+			newNames = append(newNames, name)
+		}
+	}
+	sort.Strings(newNames)
+
+	for _, name := range append(names, newNames...) {
+		parameters = append(parameters, map[string]interface{}{
+			attName:   name,
+			attValues: ssmParameters[name],
+		})
+	}
+
+	return parameters
+}
+
+func flattenTargets(ssmTargets []ssmtypes.Target) []interface{} {
+	var targets []interface{}
+
+	for _, target := range ssmTargets {
+		targets = append(targets, map[string]interface{}{
+			attKey:    aws.ToString(target.Key),
+			attValues: target.Values,
+		})
+	}
+
+	return targets
+}
+
 func getOutputLocation(d *schema.ResourceData) OutputLocation {
 	outputLocation := d.Get(attOutputLocation).([]interface{})
 
@@ -106,42 +254,737 @@ func getOutputLocation(d *schema.ResourceData) OutputLocation {
 		}
 	}
 
-	return OutputLocation{s3Bucket: s3Bucket, s3KeyPrefix: s3KeyPrefix}
-}
+	return OutputLocation{s3Bucket: s3Bucket, s3KeyPrefix: s3KeyPrefix}
+}
+
+func getCloudWatchOutputConfig(d *schema.ResourceData) *ssmtypes.CloudWatchOutputConfig {
+	cloudWatchOutputConfig := d.Get(attCloudWatchOutputConfig).([]interface{})
+
+	if len(cloudWatchOutputConfig) == 0 || cloudWatchOutputConfig[0] == nil {
+		return nil
+	}
+
+	config := cloudWatchOutputConfig[0].(map[string]interface{})
+
+	output := &ssmtypes.CloudWatchOutputConfig{
+		CloudWatchOutputEnabled: config[attCloudWatchOutputEnabled].(bool),
+	}
+
+	if logGroupName := config[attCloudWatchLogGroupName].(string); logGroupName != "" {
+		output.CloudWatchLogGroupName = &logGroupName
+	}
+
+	return output
+}
+
+func getNotificationConfig(d *schema.ResourceData) *ssmtypes.NotificationConfig {
+	notificationConfig := d.Get(attNotificationConfig).([]interface{})
+
+	if len(notificationConfig) == 0 || notificationConfig[0] == nil {
+		return nil
+	}
+
+	config := notificationConfig[0].(map[string]interface{})
+	notificationArn := config[attNotificationArn].(string)
+
+	var events []ssmtypes.NotificationEvent
+	for _, event := range config[attNotificationEvents].([]interface{}) {
+		events = append(events, ssmtypes.NotificationEvent(event.(string)))
+	}
+
+	return &ssmtypes.NotificationConfig{
+		NotificationArn:    &notificationArn,
+		NotificationEvents: events,
+		NotificationType:   ssmtypes.NotificationType(config[attNotificationType].(string)),
+	}
+}
+
+func getAlarmConfiguration(d *schema.ResourceData) *ssmtypes.AlarmConfiguration {
+	alarmConfiguration := d.Get(attAlarmConfiguration).([]interface{})
+
+	if len(alarmConfiguration) == 0 || alarmConfiguration[0] == nil {
+		return nil
+	}
+
+	config := alarmConfiguration[0].(map[string]interface{})
+
+	var alarms []ssmtypes.Alarm
+	for _, a := range config[attAlarm].([]interface{}) {
+		name := a.(map[string]interface{})[attName].(string)
+		alarms = append(alarms, ssmtypes.Alarm{Name: &name})
+	}
+
+	return &ssmtypes.AlarmConfiguration{
+		Alarms:                 alarms,
+		IgnorePollAlarmFailure: config[attIgnorePollAlarmFailure].(bool),
+	}
+}
+
+// Retrieves the names of the CloudWatch log streams of the command outputs.
+// SSM names the log streams after the command Id, the instance Id and the plugin name.
+func (clients AwsClients) listCommandLogStreams(ctx context.Context, commandId string) ([]string, error) {
+	var logStreams []string
+
+	invocations, err := clients.listCommandInvocations(ctx, commandId, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invocation := range invocations {
+		for _, plugin := range invocation.CommandPlugins {
+			pluginId := strings.ReplaceAll(aws.ToString(plugin.Name), ":", "-")
+			prefix := fmt.Sprintf("%s/%s/%s", commandId, aws.ToString(invocation.InstanceId), pluginId)
+			logStreams = append(logStreams, prefix+"/stdout", prefix+"/stderr")
+		}
+	}
+
+	sort.Strings(logStreams)
+
+	return logStreams, nil
+}
+
+// Returns the document to run on the resource creation, the run script documents for the inline commands.
+func getCommandDocumentName(d *schema.ResourceData) string {
+	if _, ok := d.GetOk(attCommands); ok {
+		return ssmDocumentRunShellScript
+	}
+
+	if _, ok := d.GetOk(attPowerShell); ok {
+		return ssmDocumentRunPowerShellScript
+	}
+
+	return d.Get(attDocumentName).(string)
+}
+
+// Returns the inline commands, one line per command.
+// The multi-line PowerShell scripts, e.g. heredocs, are split into lines without their carriage returns.
+func getInlineCommands(d *schema.ResourceData) []string {
+	if _, ok := d.GetOk(attPowerShell); !ok {
+		return getStringList(d, attCommands)
+	}
+
+	var lines []string
+	for _, script := range getStringList(d, attPowerShell) {
+		for _, line := range strings.Split(strings.TrimSuffix(script, "\n"), "\n") {
+			lines = append(lines, strings.TrimSuffix(line, "\r"))
+		}
+	}
+
+	return lines
+}
+
+// Sets the working directory and execution timeout parameters of the run script documents,
+// unless the parameters blocks set them.
+func setRunScriptParameters(d *schema.ResourceData, input *ssm.SendCommandInput, executionTimeout int) {
+	documentName := aws.ToString(input.DocumentName)
+	if documentName != ssmDocumentRunShellScript && documentName != ssmDocumentRunPowerShellScript {
+		return
+	}
+
+	if _, ok := input.Parameters[ssmParameterExecutionTimeout]; !ok {
+		input.Parameters[ssmParameterExecutionTimeout] = []string{strconv.Itoa(executionTimeout)}
+	}
+
+	if _, ok := input.Parameters[ssmParameterWorkingDirectory]; !ok {
+		if v, ok := d.GetOk(attWorkingDirectory); ok {
+			input.Parameters[ssmParameterWorkingDirectory] = []string{v.(string)}
+		}
+	}
+}
+
+// Builds the inputs to send the document to the instance Ids by batches, or to the targets of the resource.
+func getSendCommandInputs(d *schema.ResourceData, documentName string, parametersKey string) []*ssm.SendCommandInput {
+	instanceIds := getStringList(d, attInstanceIds)
+
+	if len(instanceIds) == 0 {
+		return []*ssm.SendCommandInput{getSendCommandInput(d, documentName, parametersKey)}
+	}
+
+	var inputs []*ssm.SendCommandInput
+
+	for start := 0; start < len(instanceIds); start += maxCommandInstanceIds {
+		end := min(start+maxCommandInstanceIds, len(instanceIds))
+
+		input := getSendCommandInput(d, documentName, parametersKey)
+		input.Targets = []ssmtypes.Target{{Key: &ssmTargetInstanceIds, Values: instanceIds[start:end]}}
+		inputs = append(inputs, input)
+	}
+
+	return inputs
+}
+
+// Resolves the $LATEST and $DEFAULT document versions to the version numbers of the document.
+// The version is left unresolved when not set, so that the document is not described without need.
+func (clients AwsClients) resolveDocumentVersion(ctx context.Context, documentName string, documentVersion string) (string, error) {
+	if documentVersion != documentVersionLatest && documentVersion != documentVersionDefault {
+		return documentVersion, nil
+	}
+
+	document, err := clients.GetDocument(ctx, documentName)
+
+	if err != nil {
+		return "", err
+	}
+
+	if document.Name == nil {
+		return "", fmt.Errorf("document %s not found", documentName)
+	}
+
+	if documentVersion == documentVersionLatest {
+		return aws.ToString(document.LatestVersion), nil
+	}
+
+	return aws.ToString(document.DefaultVersion), nil
+}
+
+// Retrieves the invocations of the commands with their standard output and error, sorted by instance Id.
+// SSM truncates the standard output to 24000 characters and the standard error to 8000 characters per plugin.
+func (clients AwsClients) flattenCommandInvocationOutputs(ctx context.Context, commandIds []string) ([]interface{}, error) {
+	var invocations []ssmtypes.CommandInvocation
+
+	for _, commandId := range commandIds {
+		commandInvocations, err := clients.listCommandInvocations(ctx, commandId, true)
+
+		if err != nil {
+			return nil, err
+		}
+
+		invocations = append(invocations, commandInvocations...)
+	}
+
+	sort.Slice(invocations, func(i, j int) bool {
+		return aws.ToString(invocations[i].InstanceId) < aws.ToString(invocations[j].InstanceId)
+	})
+
+	var blocks []interface{}
+
+	for _, invocation := range invocations {
+		var stdout, stderr []string
+
+		// The response code of the invocation is the one of its last plugin which ran.
+		responseCode := -1
+
+		for _, plugin := range invocation.CommandPlugins {
+			if plugin.ResponseFinishDateTime != nil {
+				responseCode = int(plugin.ResponseCode)
+			}
+
+			output, err := clients.ssmClient.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+				CommandId:  invocation.CommandId,
+				InstanceId: invocation.InstanceId,
+				PluginName: plugin.Name,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			stdout = append(stdout, aws.ToString(output.StandardOutputContent))
+			stderr = append(stderr, aws.ToString(output.StandardErrorContent))
+		}
+
+		blocks = append(blocks, map[string]interface{}{
+			attInstanceId:   aws.ToString(invocation.InstanceId),
+			attStatus:       string(invocation.Status),
+			attResponseCode: responseCode,
+			attStdout:       strings.Join(stdout, ""),
+			attStderr:       strings.Join(stderr, ""),
+		})
+	}
+
+	return blocks, nil
+}
+
+// Summarizes the status of the commands sent to the batches of instance Ids.
+// The status is the one of the first command which did not succeed, the counts are summed up.
+func (clients AwsClients) getCommandStatusValues(ctx context.Context, commands []ssmtypes.Command) (map[string]interface{}, error) {
+	status := commands[0].Status
+	statusDetails := aws.ToString(commands[0].StatusDetails)
+	var targetCount, completedCount, errorCount, deliveryTimedOutCount int32
+	var completedTime time.Time
+
+	for _, command := range commands {
+		if status == ssmtypes.CommandStatusSuccess && command.Status != ssmtypes.CommandStatusSuccess {
+			status = command.Status
+			statusDetails = aws.ToString(command.StatusDetails)
+		}
+
+		targetCount += command.TargetCount
+		completedCount += command.CompletedCount
+		errorCount += command.ErrorCount
+		deliveryTimedOutCount += command.DeliveryTimedOutCount
+
+		commandCompletedTime, err := clients.getCommandCompletedTime(ctx, aws.ToString(command.CommandId))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if commandCompletedTime.After(completedTime) {
+			completedTime = commandCompletedTime
+		}
+	}
+
+	values := map[string]interface{}{
+		attStatus:                status,
+		attStatusDetails:         statusDetails,
+		attRequestedTime:         commands[0].RequestedDateTime.UTC().Format(time.RFC3339),
+		attTargetCount:           targetCount,
+		attCompletedCount:        completedCount,
+		attErrorCount:            errorCount,
+		attDeliveryTimedOutCount: deliveryTimedOutCount,
+		attCompletedTime:         "",
+	}
+
+	if !completedTime.IsZero() {
+		values[attCompletedTime] = completedTime.UTC().Format(time.RFC3339)
+	}
+
+	return values, nil
+}
+
+// Decodes the JSON object printed by the command, the values which are not strings are kept as JSON.
+func decodeOutputMap(stdout string) (map[string]string, error) {
+	var decoded map[string]interface{}
+
+	if err := json.Unmarshal([]byte(stdout), &decoded); err != nil {
+		return nil, err
+	}
+
+	outputMap := make(map[string]string)
+	for key, value := range decoded {
+		if str, ok := value.(string); ok {
+			outputMap[key] = str
+		} else {
+			bytes, _ := json.Marshal(value)
+			outputMap[key] = string(bytes)
+		}
+	}
+
+	return outputMap, nil
+}
+
+// Writes the full outputs of the commands to the local file, from the output S3 bucket when it is specified,
+// otherwise from the outputs of the invocations truncated by SSM.
+func (clients AwsClients) writeCommandOutputFile(ctx context.Context, path string, commandIds []string, outputLocation OutputLocation, invocations []interface{}) error {
+	var output strings.Builder
+
+	if outputLocation.s3Bucket != nil {
+		for _, commandId := range commandIds {
+			commandOutput, err := clients.readCommandOutput(ctx, outputLocation.s3KeyPrefix, commandId, outputLocation.s3Bucket, "")
+
+			if err != nil {
+				return err
+			}
+
+			output.WriteString(commandOutput)
+		}
+	} else {
+		for _, i := range invocations {
+			invocation := i.(map[string]interface{})
+			output.WriteString(fmt.Sprintf("*** %s stdout ***\n%s\n", invocation[attInstanceId], invocation[attStdout]))
+			output.WriteString(fmt.Sprintf("*** %s stderr ***\n%s\n", invocation[attInstanceId], invocation[attStderr]))
+		}
+	}
+
+	// The outputs often carry secrets, the file is only readable by the user, even when it already exists.
+	if err := os.WriteFile(path, []byte(output.String()), 0600); err != nil {
+		return err
+	}
+
+	return os.Chmod(path, 0600)
+}
+
+// Builds the input to send the document of the resource with its parameters.
+func getSendCommandInput(d *schema.ResourceData, documentName string, parametersKey string) *ssm.SendCommandInput {
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	input := &ssm.SendCommandInput{
+		Targets:                getTargets(d),
+		DocumentName:           &documentName,
+		Parameters:             getParameters(d, parametersKey),
+		Comment:                &comment,
+		TimeoutSeconds:         &sendTimeout,
+		OutputS3BucketName:     outputLocation.s3Bucket,
+		OutputS3KeyPrefix:      outputLocation.s3KeyPrefix,
+		CloudWatchOutputConfig: getCloudWatchOutputConfig(d),
+		NotificationConfig:     getNotificationConfig(d),
+		AlarmConfiguration:     getAlarmConfiguration(d),
+	}
+
+	if v, ok := d.GetOk(attServiceRoleArn); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// Returns the timeout of the operation as written in the timeouts block of the configuration.
+// Returns "" when the timeout is not set.
+func getConfiguredTimeout(config cty.Value, key string) string {
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return ""
+	}
+
+	timeouts := config.GetAttr(schema.TimeoutsConfigKey)
+	if timeouts.IsNull() || !timeouts.IsKnown() || !timeouts.Type().HasAttribute(key) {
+		return ""
+	}
+
+	timeout := timeouts.GetAttr(key)
+	if timeout.IsNull() || !timeout.IsKnown() {
+		return ""
+	}
+
+	return timeout.AsString()
+}
+
+// Returns the timeout in seconds of the create or update operation when it is set in the timeouts block.
+// Returns 0 when the operation has the default timeout.
+func getCommandOperationTimeout(d *schema.ResourceData) int {
+	key := schema.TimeoutUpdate
+	if d.IsNewResource() {
+		key = schema.TimeoutCreate
+	}
+
+	if getConfiguredTimeout(d.GetRawConfig(), key) == "" {
+		return 0
+	}
+
+	return int(d.Timeout(key).Seconds())
+}
+
+// Returns the clients polling at the interval of the resource when it is set, at the interval of the provider otherwise.
+func getCommandClients(d *schema.ResourceData, awsClients *AwsClients) *AwsClients {
+	if v, ok := d.GetOk(attPollInterval); ok {
+		clients := *awsClients
+		clients.pollInterval = v.(int)
+		return &clients
+	}
+
+	return awsClients
+}
+
+// Returns the timeout in seconds of the wait for the target instances to be online, 0 to not wait.
+func getInstanceOnlineTimeout(d *schema.ResourceData, defaultValue int) int {
+	if !d.Get(attWaitForInstances).(bool) {
+		return 0
+	}
+
+	if v, ok := d.GetOk(attInstanceOnlineTimeout); ok {
+		return v.(int)
+	}
+
+	return defaultValue
+}
+
+// Returns the max attempts, the failures to retry on and the backoff of the retry block.
+// Without retry block, the command is sent once.
+func getCommandRetry(d *schema.ResourceData) (int, []string, time.Duration) {
+	if _, ok := d.GetOk(attRetry); !ok {
+		return 1, nil, 0
+	}
+
+	retryKey := attRetry + ".0."
+
+	retryOn := getStringList(d, retryKey+attRetryOn)
+	if len(retryOn) == 0 {
+		retryOn = defaultRetryOn
+	}
+
+	backoff, _ := time.ParseDuration(d.Get(retryKey + attBackoff).(string))
+
+	return d.Get(retryKey + attMaxAttempts).(int), retryOn, backoff
+}
+
+// Returns whether the command failed with one of the failures to retry on.
+func isCommandFailureRetryable(err error, retryOn []string) bool {
+	var failures []string
+
+	var invocationErr *CommandInvocationError
+	if errors.As(err, &invocationErr) {
+		failures = append(failures, string(invocationErr.Status), strings.ReplaceAll(invocationErr.StatusDetails, " ", ""))
+	}
+
+	var commandErr *CommandError
+	if errors.As(err, &commandErr) {
+		failures = append(failures, string(commandErr.Status))
+		if commandErr.DeliveryTimedOutCount > 0 {
+			failures = append(failures, retryOnDeliveryTimedOut)
+		}
+	}
+
+	for _, failure := range failures {
+		if slices.Contains(retryOn, failure) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Runs the command and sends it again on the failures to retry on, up to the max attempts of the retry block.
+func (clients AwsClients) runCommandWithRetry(ctx context.Context, d *schema.ResourceData, input *ssm.SendCommandInput, targetsTimeout int, executionTimeout *int, maxOutputSize int, waitForCompletion bool) (ssmtypes.Command, error) {
+	maxAttempts, retryOn, backoff := getCommandRetry(d)
+
+	for attempt := 1; ; attempt++ {
+		command, err := clients.runCommand(ctx, input, targetsTimeout, executionTimeout, maxOutputSize, waitForCompletion)
+
+		if err == nil || attempt >= maxAttempts || !isCommandFailureRetryable(err, retryOn) {
+			return command, err
+		}
+
+		log.Warn(ctx, fmt.Sprintf("Command attempt %d of %d failed, retrying in %s: %s", attempt, maxAttempts, backoff, err))
+
+		select {
+		case <-ctx.Done():
+			return command, err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// Validates that the backoff can be parsed as a duration.
+func validCommandRetryBackoff(v any, k string) (ws []string, es []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+	}
+
+	return
+}
+
+// Fails the plan when the command would be sent while the change calendars are closed.
+// Calendars unknown at plan time or not created yet are checked before the command is sent.
+func resourceCommandCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// The create and update timeouts are sent as the timeout of the command.
+	for _, key := range []string{schema.TimeoutCreate, schema.TimeoutUpdate} {
+		if v := getConfiguredTimeout(d.GetRawConfig(), key); v != "" {
+			timeout, err := time.ParseDuration(v)
+			if err == nil && timeout < minCommandTimeout {
+				return fmt.Errorf("%s.%s must be at least %s, got %s", schema.TimeoutsConfigKey, key, minCommandTimeout, v)
+			}
+		}
+	}
+
+	if d.Id() != "" && (d.Get(attRunOn).(string) == runOnEveryApply || d.HasChange(attTriggers) || d.Get(attRunOn).(string) == runOnUpdateOnly && d.Get(attRerunOnChange).(bool) && d.HasChanges(commandInputKeys...)) {
+		for _, key := range commandComputedKeys {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Get(attChangeCalendarAction).(string) != changeCalendarActionFail || !d.NewValueKnown(attRespectChangeCalendar) {
+		return nil
+	}
+
+	if d.Id() != "" && len(d.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return errors.New("meta argument should be of type *AwsClients")
+	}
+
+	var calendarNames []string
+	for _, name := range d.Get(attRespectChangeCalendar).([]interface{}) {
+		calendarNames = append(calendarNames, name.(string))
+	}
+
+	err := awsClients.checkChangeCalendars(ctx, calendarNames, changeCalendarActionFail)
+
+	var notFound *ssmtypes.InvalidDocument
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	return err
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The command is not sent until the first update.
+	if d.IsNewResource() && d.Get(attRunOn).(string) == runOnUpdateOnly {
+		d.SetId(resource.UniqueId())
+		return diags
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	documentName := getCommandDocumentName(d)
+	inputs := getSendCommandInputs(d, documentName, attParameters)
+	maxOutputSize := d.Get(attMaxOutputSize).(int)
+	waitForCompletion := d.Get(attWaitForCompletion).(bool)
+
+	targetsTimeout := getInstanceOnlineTimeout(d, waitTimeout)
+	invocationsTimeout := executionTimeout
+	contextTimeout := targetsTimeout + executionTimeout + 60
+
+	// The timeout of the timeouts block replaces the default waits.
+	// The execution timeout of the document stays the one of the resource.
+	timeout := getCommandOperationTimeout(d)
+	if timeout > 0 {
+		targetsTimeout = getInstanceOnlineTimeout(d, timeout)
+		invocationsTimeout = timeout
+		contextTimeout = timeout
+	}
+
+	// The batches of instance Ids are sent one after the other, each of them up to the max attempts.
+	maxAttempts, _, backoff := getCommandRetry(d)
+	extendedCtx, cancel := context.WithTimeout(ctx, (time.Duration(contextTimeout)*time.Second+backoff)*time.Duration(len(inputs)*maxAttempts))
+	defer cancel()
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	awsClients = getCommandClients(d, awsClients)
+
+	if err := awsClients.checkChangeCalendars(ctx, getStringList(d, attRespectChangeCalendar), d.Get(attChangeCalendarAction).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The command is pinned to the resolved version, so that the version recorded is the version executed.
+	documentVersion, err := awsClients.resolveDocumentVersion(ctx, documentName, d.Get(attDocumentVersion).(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var commands []ssmtypes.Command
+	var commandIds []string
+
+	for _, input := range inputs {
+		if documentVersion != "" {
+			input.DocumentVersion = &documentVersion
+		}
+
+		if inlineCommands := getInlineCommands(d); len(inlineCommands) > 0 {
+			input.Parameters[ssmParameterCommands] = inlineCommands
+		}
+
+		setRunScriptParameters(d, input, executionTimeout)
+
+		if timeout > 0 {
+			input.TimeoutSeconds = aws.Int32(int32(timeout))
+		}
+
+		// SSM rejects the command when the hash does not match the content of the document.
+		if v, ok := d.GetOk(attDocumentHash); ok {
+			input.DocumentHash = aws.String(v.(string))
+			input.DocumentHashType = ssmtypes.DocumentHashType(d.Get(attDocumentHashType).(string))
+		}
+
+		command, err := awsClients.runCommandWithRetry(extendedCtx, d, input, targetsTimeout, &invocationsTimeout, maxOutputSize, waitForCompletion)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		commands = append(commands, command)
+		commandIds = append(commandIds, *command.CommandId)
+	}
+
+	d.SetId(commandIds[0])
+
+	values, err := awsClients.getCommandStatusValues(ctx, commands)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values[attCommandId] = commandIds[0]
 
-func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	documentName := d.Get(attDocumentName).(string)
-	executionTimeout := d.Get(attExecutionTimeout).(int)
-	comment := d.Get(attComment).(string)
-	ssmParameters := getParameters(d, attParameters)
-	ssmTargets := getTargets(d)
-	outputLocation := getOutputLocation(d)
+	if err := d.Set(attResolvedDocumentVersion, documentVersion); err != nil {
+		return diag.FromErr(err)
+	}
 
-	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
-	defer cancel()
+	if err := d.Set(attCommandIds, commandIds); err != nil {
+		return diag.FromErr(err)
+	}
 
-	awsClients, ok := m.(*AwsClients)
-	if !ok {
-		return diag.Errorf("meta argument should be of type *AwsClients")
+	// The outputs of the command are unknown until it completes.
+	if !waitForCompletion {
+		return diags
 	}
 
-	command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, ssmTargets, &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+	invocations, err := awsClients.flattenCommandInvocationOutputs(ctx, commandIds)
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(*command.CommandId)
+	if d.Get(attOutputFormat).(string) == outputFormatJson {
+		for _, i := range invocations {
+			invocation := i.(map[string]interface{})
+
+			// The failed invocations tolerated by max errors may not print their JSON output.
+			if invocation[attStatus] != string(ssmtypes.CommandInvocationStatusSuccess) {
+				continue
+			}
+
+			outputMap, err := decodeOutputMap(invocation[attStdout].(string))
+
+			if err != nil {
+				return diag.Errorf("output of command on instance %s is not a JSON object: %s", invocation[attInstanceId], err)
+			}
+
+			invocation[attOutputMap] = outputMap
+		}
+	}
+
+	if v, ok := d.GetOk(attOutputFile); ok {
+		if err := awsClients.writeCommandOutputFile(ctx, v.(string), commandIds, getOutputLocation(d), invocations); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The outputs are stored in the state truncated to max output size.
+	if maxOutputSize > 0 {
+		for _, i := range invocations {
+			invocation := i.(map[string]interface{})
+			for _, key := range []string{attStdout, attStderr} {
+				invocation[key] = truncateOutput(invocation[key].(string), maxOutputSize)
+			}
+		}
+	}
 
-	if err := d.Set(attStatus, command.Status); err != nil {
+	if err := d.Set(attInvocations, invocations); err != nil {
 		return diag.FromErr(err)
 	}
 
-	requestedTime := command.RequestedDateTime.UTC().Format(time.RFC3339)
+	var logStreams []string
+	if inputs[0].CloudWatchOutputConfig != nil && inputs[0].CloudWatchOutputConfig.CloudWatchOutputEnabled {
+		for _, commandId := range commandIds {
+			commandLogStreams, err := awsClients.listCommandLogStreams(ctx, commandId)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			logStreams = append(logStreams, commandLogStreams...)
+		}
+	}
 
-	if err := d.Set(attRequestedTime, requestedTime); err != nil {
+	if err := d.Set(attCloudWatchLogStreams, logStreams); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -151,39 +994,63 @@ func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, m interf
 func resourceCommandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	commandId := d.Id()
-
 	awsClients, ok := m.(*AwsClients)
 	if !ok {
 		return diag.Errorf("meta argument should be of type *AwsClients")
 	}
 
-	command, err := awsClients.GetCommand(ctx, commandId)
-
-	if err != nil {
-		return diag.FromErr(err)
+	// The imported resources have no command Ids yet.
+	commandIds := getStringList(d, attCommandIds)
+	if len(commandIds) == 0 && d.Get(attRunOn).(string) == runOnUpdateOnly {
+		return diags
+	}
+	if len(commandIds) == 0 {
+		commandIds = []string{d.Id()}
 	}
 
-	if command.CommandId == nil {
-		d.SetId("")
-		return diags
+	var commands []ssmtypes.Command
+
+	for _, commandId := range commandIds {
+		command, err := awsClients.GetCommand(ctx, commandId)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if command.CommandId == nil {
+			d.SetId("")
+			return diags
+		}
+
+		commands = append(commands, command)
 	}
 
-	if err := d.Set(attStatus, command.Status); err != nil {
+	values, err := awsClients.getCommandStatusValues(ctx, commands)
+
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	requestedTime := command.RequestedDateTime.UTC().Format(time.RFC3339)
+	values[attCommandId] = commandIds[0]
+	values[attCommandIds] = commandIds
 
-	if err := d.Set(attRequestedTime, requestedTime); err != nil {
-		return diag.FromErr(err)
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return diags
 }
 
 func resourceCommandUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return resourceCommandCreate(ctx, d, m)
+	// The triggers send the command again whatever rerun on change.
+	// The command with run on create runs once at creation, the changes of its inputs are only recorded in the state.
+	if d.Get(attRunOn).(string) == runOnEveryApply || d.HasChange(attTriggers) || d.Get(attRunOn).(string) == runOnUpdateOnly && d.Get(attRerunOnChange).(bool) && d.HasChanges(commandInputKeys...) {
+		return resourceCommandCreate(ctx, d, m)
+	}
+
+	return resourceCommandRead(ctx, d, m)
 }
 
 func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -194,21 +1061,46 @@ func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("meta argument should be of type *AwsClients")
 	}
 
+	awsClients = getCommandClients(d, awsClients)
+
 	documentName := d.Get(attDestroyDocumentName).(string)
+	parametersKey := attDestroyParameters
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	ignoreFailure := false
+
+	if _, ok := d.GetOk(attOnDestroy); ok {
+		onDestroyKey := attOnDestroy + ".0."
+		documentName = d.Get(onDestroyKey + attDocumentName).(string)
+		parametersKey = onDestroyKey + attParameters
+		executionTimeout = d.Get(onDestroyKey + attExecutionTimeout).(int)
+		ignoreFailure = d.Get(onDestroyKey + attIgnoreFailure).(bool)
+	}
 
 	if documentName != "" {
-		executionTimeout := d.Get(attExecutionTimeout).(int)
-		comment := d.Get(attComment).(string)
-		ssmParameters := getParameters(d, attDestroyParameters)
-		ssmTargets := getTargets(d)
-		outputLocation := getOutputLocation(d)
+		inputs := getSendCommandInputs(d, documentName, parametersKey)
+
+		if err := awsClients.checkChangeCalendars(ctx, getStringList(d, attRespectChangeCalendar), d.Get(attChangeCalendarAction).(string)); err != nil {
+			return diag.FromErr(err)
+		}
 
-		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		maxAttempts, _, backoff := getCommandRetry(d)
+		extendedCtx, cancel := context.WithTimeout(ctx, (time.Duration(executionTimeout+60)*time.Second+backoff)*time.Duration(len(inputs)*maxAttempts))
 		defer cancel()
 
-		_, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, ssmTargets, &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
-		if err != nil {
-			return diag.FromErr(err)
+		for _, input := range inputs {
+			setRunScriptParameters(d, input, executionTimeout)
+
+			_, err := awsClients.runCommandWithRetry(extendedCtx, d, input, getInstanceOnlineTimeout(d, waitTimeout), &executionTimeout, d.Get(attMaxOutputSize).(int), true)
+
+			// The destroy command fails on the instances which are already terminated.
+			if err != nil && ignoreFailure {
+				log.Warn(ctx, fmt.Sprintf("Destroy command failed, the failure is ignored: %s", err))
+				continue
+			}
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -223,21 +1115,55 @@ func resourceCommand() *schema.Resource {
 			Create:  &createTimeout,
 			Read:    &readTimeout,
 			Update:  &updateTimeout,
-			Delete:  &deleteTimeout,
+			Delete:  &updateTimeout,
 			Default: &defaultTimeout,
 		},
 		CreateContext: resourceCommandCreate,
 		ReadContext:   resourceCommandRead,
 		UpdateContext: resourceCommandUpdate,
 		DeleteContext: resourceCommandDelete,
+		CustomizeDiff: resourceCommandCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			attDocumentName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{attDocumentName, attCommands, attPowerShell},
+			},
+			attCommands: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{attDocumentName, attCommands, attPowerShell},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDocumentHash: {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			attDocumentHashType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.DocumentHashTypeSha256),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.DocumentHashType("").Values()), false),
+			},
+			attPowerShell: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{attDocumentName, attCommands, attPowerShell},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			attParameters: {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						attName: {
@@ -255,12 +1181,16 @@ func resourceCommand() *schema.Resource {
 				},
 			},
 			attDestroyDocumentName: {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use the on_destroy block instead.",
+				ConflictsWith: []string{attOnDestroy},
 			},
 			attDestroyParameters: {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Deprecated:    "Use the on_destroy block instead.",
+				ConflictsWith: []string{attOnDestroy},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						attName: {
@@ -277,9 +1207,60 @@ func resourceCommand() *schema.Resource {
 					},
 				},
 			},
-			attTargets: {
+			attOnDestroy: {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attDocumentName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attParameters: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									attValues: {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						attExecutionTimeout: {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  600,
+						},
+						attIgnoreFailure: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			attInstanceIds: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{attTargets, attInstanceIds},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attTargets: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{attTargets, attInstanceIds},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						attKey: {
@@ -301,11 +1282,109 @@ func resourceCommand() *schema.Resource {
 				Optional: true,
 				Default:  3600,
 			},
+			attOutputFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      outputFormatText,
+				ValidateFunc: validation.StringInSlice([]string{outputFormatText, outputFormatJson}, false),
+			},
+			attMaxOutputSize: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			attOutputFile: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attWaitForInstances: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attInstanceOnlineTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(sleepTime),
+			},
+			attPollInterval: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			attRetry: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attMaxAttempts: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						attRetryOn: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									retryOnFailed, retryOnTimedOut, retryOnDeliveryTimedOut,
+									retryOnExecutionTimedOut, retryOnUndeliverable, retryOnCancelled,
+								}, false),
+							},
+						},
+						attBackoff: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30s",
+							ValidateFunc: validCommandRetryBackoff,
+						},
+					},
+				},
+			},
+			attRunOn: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      runOnCreate,
+				ValidateFunc: validation.StringInSlice([]string{runOnCreate, runOnEveryApply, runOnUpdateOnly}, false),
+			},
+			attRerunOnChange: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attWorkingDirectory: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			attComment: {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "",
 			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			attOutputLocation: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -324,6 +1403,98 @@ func resourceCommand() *schema.Resource {
 					},
 				},
 			},
+			attCloudWatchOutputConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attCloudWatchLogGroupName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attCloudWatchOutputEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			attNotificationConfig: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{attServiceRoleArn},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attNotificationArn: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidARN,
+						},
+						attNotificationEvents: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.NotificationEvent("").Values()), false),
+							},
+						},
+						attNotificationType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(ssmtypes.NotificationTypeCommand),
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.NotificationType("").Values()), false),
+						},
+					},
+				},
+			},
+			attServiceRoleArn: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidARN,
+			},
+			attAlarmConfiguration: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attAlarm: {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						attIgnorePollAlarmFailure: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			attRespectChangeCalendar: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attChangeCalendarAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      changeCalendarActionFail,
+				ValidateFunc: validation.StringInSlice([]string{changeCalendarActionFail, changeCalendarActionDefer}, false),
+			},
 			attStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -332,6 +1503,87 @@ func resourceCommand() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			attStatusDetails: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attCompletedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attTargetCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attCompletedCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attErrorCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attDeliveryTimedOutCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attResolvedDocumentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attCommandId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attCommandIds: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInvocations: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attResponseCode: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attStdout: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStderr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attOutputMap: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attCloudWatchLogStreams: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
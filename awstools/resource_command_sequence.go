@@ -0,0 +1,351 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_command_sequence resource
+const (
+	attStep        string = "step"
+	attStepResults string = "step_results"
+	attCommandId   string = "command_id"
+)
+
+func flattenStepResult(documentName string, command ssmtypes.Command, stdout string, stderr string) map[string]interface{} {
+	var requestedTime string
+	if command.RequestedDateTime != nil {
+		requestedTime = command.RequestedDateTime.UTC().Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		attDocumentName:  documentName,
+		attCommandId:     aws.ToString(command.CommandId),
+		attStatus:        string(command.Status),
+		attRequestedTime: requestedTime,
+		attStdout:        stdout,
+		attStderr:        stderr,
+	}
+}
+
+// Retrieves the standard output and error of the step on the target instances.
+// The outputs are retrieved from the output S3 bucket when specified, otherwise they are truncated by SSM.
+func (clients AwsClients) getStepOutputs(ctx context.Context, commandId string, outputLocation OutputLocation) (string, string, error) {
+	if outputLocation.s3Bucket != nil {
+		stdout, err := clients.readCommandOutput(ctx, outputLocation.s3KeyPrefix, commandId, outputLocation.s3Bucket, attStdout)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		stderr, err := clients.readCommandOutput(ctx, outputLocation.s3KeyPrefix, commandId, outputLocation.s3Bucket, attStderr)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return stdout, stderr, nil
+	}
+
+	invocations, err := clients.flattenCommandInvocationOutputs(ctx, []string{commandId})
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var stdout, stderr strings.Builder
+
+	for _, i := range invocations {
+		invocation := i.(map[string]interface{})
+		stdout.WriteString(fmt.Sprintf("*** %s ***\n%s\n", invocation[attInstanceId], invocation[attStdout]))
+		stderr.WriteString(fmt.Sprintf("*** %s ***\n%s\n", invocation[attInstanceId], invocation[attStderr]))
+	}
+
+	return stdout.String(), stderr.String(), nil
+}
+
+// Aggregated status is the status of the first step which did not succeed.
+func getSequenceStatus(results []interface{}) string {
+	for _, r := range results {
+		status := r.(map[string]interface{})[attStatus].(string)
+		if status != "Success" {
+			return status
+		}
+	}
+
+	return "Success"
+}
+
+func setCommandSequence(d *schema.ResourceData, results []interface{}) diag.Diagnostics {
+	if err := d.Set(attStepResults, results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attStatus, getSequenceStatus(results)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Runs the steps one after another.
+// Stops at the first step which fails.
+func resourceCommandSequenceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	comment := d.Get(attComment).(string)
+	ssmTargets := getTargets(d)
+	outputLocation := getOutputLocation(d)
+
+	var results []interface{}
+
+	for i, s := range d.Get(attStep).([]interface{}) {
+		step := s.(map[string]interface{})
+		documentName := step[attDocumentName].(string)
+		executionTimeout := step[attExecutionTimeout].(int)
+		ssmParameters := getParameters(d, fmt.Sprintf("%s.%d.%s", attStep, i, attParameters))
+
+		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, ssmTargets, &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+		cancel()
+
+		var stdout, stderr string
+		if command.CommandId != nil {
+			var outputErr error
+			stdout, stderr, outputErr = awsClients.getStepOutputs(ctx, *command.CommandId, outputLocation)
+
+			if outputErr != nil && err == nil {
+				return diag.FromErr(outputErr)
+			}
+		}
+
+		if err != nil {
+			// Keep the steps which already ran in the state of the failed resource.
+			if len(results) > 0 {
+				result := flattenStepResult(documentName, command, stdout, stderr)
+
+				// The failed invocation ends the wait before the command itself completes.
+				var invocationErr *CommandInvocationError
+				if errors.As(err, &invocationErr) {
+					result[attStatus] = string(invocationErr.Status)
+				}
+
+				// The command was not sent, e.g. the target instances are not online.
+				if command.CommandId == nil {
+					result[attStatus] = string(ssmtypes.CommandStatusFailed)
+				}
+
+				results = append(results, result)
+				setCommandSequence(d, results)
+			}
+
+			return diag.Errorf("step %d (%s) failed: %s", i+1, documentName, err)
+		}
+
+		if i == 0 {
+			d.SetId(*command.CommandId)
+		}
+
+		results = append(results, flattenStepResult(documentName, command, stdout, stderr))
+	}
+
+	return setCommandSequence(d, results)
+}
+
+func resourceCommandSequenceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	var results []interface{}
+
+	for _, r := range d.Get(attStepResults).([]interface{}) {
+		result := r.(map[string]interface{})
+		commandId := result[attCommandId].(string)
+
+		if commandId == "" {
+			results = append(results, result)
+			continue
+		}
+
+		command, err := awsClients.GetCommand(ctx, commandId)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if command.CommandId == nil {
+			if commandId == d.Id() {
+				d.SetId("")
+				return diags
+			}
+
+			results = append(results, result)
+			continue
+		}
+
+		// The outputs are retrieved once, when the step runs.
+		results = append(results, flattenStepResult(result[attDocumentName].(string), command, result[attStdout].(string), result[attStderr].(string)))
+	}
+
+	return setCommandSequence(d, results)
+}
+
+func resourceCommandSequenceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceCommandSequenceCreate(ctx, d, m)
+}
+
+func resourceCommandSequenceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceCommandSequence() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceCommandSequenceCreate,
+		ReadContext:   resourceCommandSequenceRead,
+		UpdateContext: resourceCommandSequenceUpdate,
+		DeleteContext: resourceCommandSequenceDelete,
+		Schema: map[string]*schema.Schema{
+			attStep: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attDocumentName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attParameters: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									attValues: {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						attExecutionTimeout: {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  3600,
+						},
+					},
+				},
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStepResults: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attDocumentName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attCommandId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attRequestedTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStdout: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attStderr: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,313 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_compliance_item resource
+const (
+	attComplianceType string = "compliance_type"
+	attItems          string = "items"
+	attId             string = "id"
+	attDetails        string = "details"
+	attExecutionId    string = "execution_id"
+	attExecutionType  string = "execution_type"
+	attExecutionTime  string = "execution_time"
+)
+
+var ssmComplianceResourceType = "ManagedInstance"
+var ssmComplianceFilterComplianceType = "ComplianceType"
+
+func getComplianceItems(d *schema.ResourceData) []ssmtypes.ComplianceItemEntry {
+	items := []ssmtypes.ComplianceItemEntry{}
+
+	for _, i := range d.Get(attItems).(*schema.Set).List() {
+		item := i.(map[string]interface{})
+
+		entry := ssmtypes.ComplianceItemEntry{
+			Severity: ssmtypes.ComplianceSeverity(item[attSeverity].(string)),
+			Status:   ssmtypes.ComplianceStatus(item[attStatus].(string)),
+			Details:  make(map[string]string),
+		}
+
+		if id := item[attId].(string); id != "" {
+			entry.Id = aws.String(id)
+		}
+
+		if title := item[attTitle].(string); title != "" {
+			entry.Title = aws.String(title)
+		}
+
+		for key, value := range item[attDetails].(map[string]interface{}) {
+			entry.Details[key] = value.(string)
+		}
+
+		items = append(items, entry)
+	}
+
+	return items
+}
+
+func flattenComplianceItems(items []ssmtypes.ComplianceItem) []interface{} {
+	var result []interface{}
+
+	for _, item := range items {
+		result = append(result, map[string]interface{}{
+			attId:       aws.ToString(item.Id),
+			attTitle:    aws.ToString(item.Title),
+			attSeverity: string(item.Severity),
+			attStatus:   string(item.Status),
+			attDetails:  item.Details,
+		})
+	}
+
+	return result
+}
+
+// Retrieves compliance items of the type registered for the managed instance.
+func (clients AwsClients) listComplianceItems(ctx context.Context, instanceId string, complianceType string) ([]ssmtypes.ComplianceItem, error) {
+	var items []ssmtypes.ComplianceItem
+
+	input := &ssm.ListComplianceItemsInput{
+		ResourceIds:   []string{instanceId},
+		ResourceTypes: []string{ssmComplianceResourceType},
+		Filters: []ssmtypes.ComplianceStringFilter{
+			{
+				Key:    &ssmComplianceFilterComplianceType,
+				Values: []string{complianceType},
+				Type:   ssmtypes.ComplianceQueryOperatorTypeEqual,
+			},
+		},
+	}
+
+	for {
+		output, err := clients.ssmClient.ListComplianceItems(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, output.ComplianceItems...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return items, nil
+}
+
+func (clients AwsClients) putComplianceItems(ctx context.Context, instanceId string, complianceType string, summary *ssmtypes.ComplianceExecutionSummary, items []ssmtypes.ComplianceItemEntry) error {
+	_, err := clients.ssmClient.PutComplianceItems(ctx, &ssm.PutComplianceItemsInput{
+		ResourceId:       &instanceId,
+		ResourceType:     &ssmComplianceResourceType,
+		ComplianceType:   &complianceType,
+		ExecutionSummary: summary,
+		Items:            items,
+		UploadType:       ssmtypes.ComplianceUploadTypeComplete,
+	})
+
+	return err
+}
+
+func resourceComplianceItemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Get(attInstanceId).(string)
+	complianceType := d.Get(attComplianceType).(string)
+
+	summary := &ssmtypes.ComplianceExecutionSummary{
+		ExecutionTime: aws.Time(time.Now()),
+	}
+
+	if v, ok := d.GetOk(attExecutionId); ok {
+		summary.ExecutionId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attExecutionType); ok {
+		summary.ExecutionType = aws.String(v.(string))
+	}
+
+	err := awsClients.putComplianceItems(ctx, instanceId, complianceType, summary, getComplianceItems(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceId + "/" + complianceType)
+
+	return resourceComplianceItemRead(ctx, d, m)
+}
+
+func resourceComplianceItemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceId, complianceType := ids[0], ids[1]
+
+	items, err := awsClients.listComplianceItems(ctx, instanceId, complianceType)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(items) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	values := map[string]interface{}{
+		attInstanceId:     instanceId,
+		attComplianceType: complianceType,
+		attItems:          flattenComplianceItems(items),
+	}
+
+	if summary := items[0].ExecutionSummary; summary != nil {
+		values[attExecutionId] = summary.ExecutionId
+		values[attExecutionType] = summary.ExecutionType
+
+		if summary.ExecutionTime != nil {
+			values[attExecutionTime] = summary.ExecutionTime.UTC().Format(time.RFC3339)
+		}
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceComplianceItemUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceComplianceItemCreate(ctx, d, m)
+}
+
+// Removes the compliance items of the type from the managed instance.
+func resourceComplianceItemDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	summary := &ssmtypes.ComplianceExecutionSummary{
+		ExecutionTime: aws.Time(time.Now()),
+	}
+
+	err = awsClients.putComplianceItems(ctx, ids[0], ids[1], summary, []ssmtypes.ComplianceItemEntry{})
+
+	var notFound *ssmtypes.InvalidResourceId
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceComplianceItem() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComplianceItemCreate,
+		ReadContext:   resourceComplianceItemRead,
+		UpdateContext: resourceComplianceItemUpdate,
+		DeleteContext: resourceComplianceItemDelete,
+		Schema: map[string]*schema.Schema{
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attComplianceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^Custom:[A-Za-z0-9_\-]\w+$`), "must be a custom compliance type starting with Custom:"),
+			},
+			attExecutionId: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attExecutionType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attItems: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attId: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attTitle: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attSeverity: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ComplianceSeverity("").Values()), false),
+						},
+						attStatus: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ComplianceStatus("").Values()), false),
+						},
+						attDetails: {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,299 @@
+package awstools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_custom_inventory resource
+const (
+	attTypeName      string = "type_name"
+	attSchemaVersion string = "schema_version"
+	attContentHash   string = "content_hash"
+	attCaptureTime   string = "capture_time"
+)
+
+// Capture time format expected by PutInventory
+const inventoryCaptureTimeFormat = "2006-01-02T15:04:05Z"
+
+func getInventoryContent(d *schema.ResourceData) []map[string]string {
+	content := []map[string]string{}
+
+	for _, e := range d.Get(attContent).([]interface{}) {
+		entry := make(map[string]string)
+		if e != nil {
+			for key, value := range e.(map[string]interface{}) {
+				entry[key] = value.(string)
+			}
+		}
+		content = append(content, entry)
+	}
+
+	return content
+}
+
+// Computes hash of the inventory content independent of the entries order.
+func getInventoryContentHash(content []map[string]string) (string, error) {
+	var entries []string
+
+	for _, entry := range content {
+		bytes, err := json.Marshal(entry)
+
+		if err != nil {
+			return "", err
+		}
+
+		entries = append(entries, string(bytes))
+	}
+
+	sort.Strings(entries)
+
+	bytes, err := json.Marshal(entries)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(bytes)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Retrieves custom inventory entries of the managed instance.
+// Returns empty schema version if the managed instance has no inventory of the type.
+func (clients AwsClients) listInventoryEntries(ctx context.Context, instanceId string, typeName string) (string, string, []map[string]string, error) {
+	var schemaVersion, captureTime string
+	var entries []map[string]string
+
+	input := &ssm.ListInventoryEntriesInput{
+		InstanceId: &instanceId,
+		TypeName:   &typeName,
+	}
+
+	for {
+		output, err := clients.ssmClient.ListInventoryEntries(ctx, input)
+
+		var notFound *ssmtypes.InvalidInstanceId
+		if errors.As(err, &notFound) {
+			return "", "", nil, nil
+		}
+
+		if err != nil {
+			return "", "", nil, err
+		}
+
+		schemaVersion = aws.ToString(output.SchemaVersion)
+		captureTime = aws.ToString(output.CaptureTime)
+		entries = append(entries, output.Entries...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return schemaVersion, captureTime, entries, nil
+}
+
+func (clients AwsClients) putInventory(ctx context.Context, instanceId string, typeName string, schemaVersion string, content []map[string]string) error {
+	contentHash, err := getInventoryContentHash(content)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.ssmClient.PutInventory(ctx, &ssm.PutInventoryInput{
+		InstanceId: &instanceId,
+		Items: []ssmtypes.InventoryItem{
+			{
+				TypeName:      &typeName,
+				SchemaVersion: &schemaVersion,
+				CaptureTime:   aws.String(time.Now().UTC().Format(inventoryCaptureTimeFormat)),
+				Content:       content,
+				ContentHash:   &contentHash,
+			},
+		},
+	})
+
+	return err
+}
+
+func resourceCustomInventoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Get(attInstanceId).(string)
+	typeName := d.Get(attTypeName).(string)
+	content := getInventoryContent(d)
+
+	err := awsClients.putInventory(ctx, instanceId, typeName, d.Get(attSchemaVersion).(string), content)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceId + "/" + typeName)
+
+	contentHash, err := getInventoryContentHash(content)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attContentHash, contentHash); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCustomInventoryRead(ctx, d, m)
+}
+
+func resourceCustomInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceId, typeName := ids[0], ids[1]
+
+	schemaVersion, captureTime, entries, err := awsClients.listInventoryEntries(ctx, instanceId, typeName)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if schemaVersion == "" {
+		d.SetId("")
+		return diags
+	}
+
+	contentHash, err := getInventoryContentHash(entries)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attInstanceId:    instanceId,
+		attTypeName:      typeName,
+		attSchemaVersion: schemaVersion,
+		attCaptureTime:   captureTime,
+		attContentHash:   contentHash,
+	}
+
+	// The content is only refreshed when its hash differs to keep the configured entries order.
+	if contentHash != d.Get(attContentHash).(string) {
+		values[attContent] = entries
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceCustomInventoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceCustomInventoryCreate(ctx, d, m)
+}
+
+// Clears the custom inventory of the managed instance.
+func resourceCustomInventoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = awsClients.putInventory(ctx, ids[0], ids[1], d.Get(attSchemaVersion).(string), []map[string]string{})
+
+	var notFound *ssmtypes.InvalidInstanceId
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceCustomInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomInventoryCreate,
+		ReadContext:   resourceCustomInventoryRead,
+		UpdateContext: resourceCustomInventoryUpdate,
+		DeleteContext: resourceCustomInventoryDelete,
+		Schema: map[string]*schema.Schema{
+			attInstanceId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attTypeName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^Custom:[0-9A-Za-z_.-]+$`), "must be a custom inventory type name starting with Custom:"),
+			},
+			attSchemaVersion: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1.0",
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]{1,6}(\.[0-9]{1,6})$`), "must be a version of the form 1.0"),
+			},
+			attContent: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			attContentHash: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attCaptureTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDefaultPatchBaselineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Get(attBaselineId).(string)
+
+	_, err := awsClients.ssmClient.RegisterDefaultPatchBaseline(ctx, &ssm.RegisterDefaultPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get(attOperatingSystem).(string))
+
+	return resourceDefaultPatchBaselineRead(ctx, d, m)
+}
+
+func resourceDefaultPatchBaselineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	output, err := awsClients.ssmClient.GetDefaultPatchBaseline(ctx, &ssm.GetDefaultPatchBaselineInput{
+		OperatingSystem: ssmtypes.OperatingSystem(d.Id()),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The configured baseline may be referenced by ARN while SSM returns its Id.
+	baselineId := d.Get(attBaselineId).(string)
+	if baselineId == "" || !strings.HasSuffix(baselineId, aws.ToString(output.BaselineId)) {
+		baselineId = aws.ToString(output.BaselineId)
+	}
+
+	if err := d.Set(attBaselineId, baselineId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attOperatingSystem, output.OperatingSystem); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDefaultPatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Get(attBaselineId).(string)
+
+	_, err := awsClients.ssmClient.RegisterDefaultPatchBaseline(ctx, &ssm.RegisterDefaultPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDefaultPatchBaselineRead(ctx, d, m)
+}
+
+// Restores the AWS provided default patch baseline of the operating system.
+func resourceDefaultPatchBaselineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId, err := awsClients.getAwsDefaultPatchBaselineId(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.RegisterDefaultPatchBaseline(ctx, &ssm.RegisterDefaultPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDefaultPatchBaseline() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultPatchBaselineCreate,
+		ReadContext:   resourceDefaultPatchBaselineRead,
+		UpdateContext: resourceDefaultPatchBaselineUpdate,
+		DeleteContext: resourceDefaultPatchBaselineDelete,
+		Schema: map[string]*schema.Schema{
+			attBaselineId: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attOperatingSystem: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OperatingSystem("").Values()), false),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
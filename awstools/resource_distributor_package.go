@@ -0,0 +1,385 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_distributor_package resource
+const (
+	attFile         string = "file"
+	attArchitecture string = "architecture"
+	attManifest     string = "manifest"
+)
+
+// Distributor package manifest
+type packageManifest struct {
+	SchemaVersion string                                               `json:"schemaVersion"`
+	Version       string                                               `json:"version"`
+	Packages      map[string]map[string]map[string]packageManifestFile `json:"packages"`
+	Files         map[string]packageManifestChecksums                  `json:"files"`
+}
+
+type packageManifestFile struct {
+	File string `json:"file"`
+}
+
+type packageManifestChecksums struct {
+	Checksums map[string]string `json:"checksums"`
+}
+
+// Builds the package manifest from the file blocks.
+// The checksums of the local files are computed, so that the changes of the files change the manifest.
+func getPackageManifest(d interface{ Get(string) interface{} }) (string, error) {
+	manifest := packageManifest{
+		SchemaVersion: "2.0",
+		Version:       d.Get(attVersionName).(string),
+		Packages:      make(map[string]map[string]map[string]packageManifestFile),
+		Files:         make(map[string]packageManifestChecksums),
+	}
+
+	for _, f := range d.Get(attFile).([]interface{}) {
+		file := f.(map[string]interface{})
+		source := file[attSource].(string)
+		name := filepath.Base(source)
+
+		content, err := os.ReadFile(source)
+
+		if err != nil {
+			return "", err
+		}
+
+		platformName := file[attPlatformName].(string)
+		platformVersion := file[attPlatformVersion].(string)
+
+		if manifest.Packages[platformName] == nil {
+			manifest.Packages[platformName] = make(map[string]map[string]packageManifestFile)
+		}
+
+		if manifest.Packages[platformName][platformVersion] == nil {
+			manifest.Packages[platformName][platformVersion] = make(map[string]packageManifestFile)
+		}
+
+		manifest.Packages[platformName][platformVersion][file[attArchitecture].(string)] = packageManifestFile{File: name}
+		manifest.Files[name] = packageManifestChecksums{Checksums: map[string]string{"sha256": getContentSha256(content)}}
+	}
+
+	content, err := json.Marshal(manifest)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func getPackageSourceUrl(d *schema.ResourceData) string {
+	url := "https://s3.amazonaws.com/" + d.Get(attS3BucketName).(string)
+
+	if prefix := d.Get(attS3KeyPrefix).(string); prefix != "" {
+		url += "/" + prefix
+	}
+
+	return url
+}
+
+// Uploads the package files to the S3 bucket the package attachments are read from.
+func uploadPackageFiles(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData) error {
+	s3Bucket := d.Get(attS3BucketName).(string)
+	prefix := d.Get(attS3KeyPrefix).(string)
+
+	for _, f := range d.Get(attFile).([]interface{}) {
+		source := f.(map[string]interface{})[attSource].(string)
+
+		content, err := os.ReadFile(source)
+
+		if err != nil {
+			return err
+		}
+
+		key := filepath.Base(source)
+		if prefix != "" {
+			key = prefix + "/" + key
+		}
+
+		if _, err := awsClients.uploadObject(ctx, s3Bucket, key, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Changes of the package files are detected with the checksums of the manifest.
+func resourceDistributorPackageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(attFile) || !d.NewValueKnown(attVersionName) {
+		return d.SetNewComputed(attManifest)
+	}
+
+	manifest, err := getPackageManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	if manifest != d.Get(attManifest).(string) {
+		return d.SetNew(attManifest, manifest)
+	}
+
+	return nil
+}
+
+func resourceDistributorPackageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	manifest, err := getPackageManifest(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := uploadPackageFiles(ctx, awsClients, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.CreateDocument(ctx, &ssm.CreateDocumentInput{
+		Name:         &name,
+		Content:      &manifest,
+		DocumentType: ssmtypes.DocumentTypePackage,
+		VersionName:  aws.String(d.Get(attVersionName).(string)),
+		Attachments: []ssmtypes.AttachmentsSource{
+			{
+				Key:    ssmtypes.AttachmentsSourceKeySourceUrl,
+				Values: []string{getPackageSourceUrl(d)},
+			},
+		},
+		Tags: expandTags(d.Get(attTags).(map[string]interface{})),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attManifest, manifest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDistributorPackageRead(ctx, d, m)
+}
+
+func resourceDistributorPackageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	document, err := awsClients.GetDocument(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	values := map[string]interface{}{
+		attName:           document.Name,
+		attLatestVersion:  document.LatestVersion,
+		attDefaultVersion: document.DefaultVersion,
+		attTags:           flattenTags(document.Tags),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// Publishes the new package version and makes it the default version.
+func resourceDistributorPackageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	if d.HasChanges(attFile, attVersionName, attManifest, attS3BucketName, attS3KeyPrefix) {
+		manifest, err := getPackageManifest(d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := uploadPackageFiles(ctx, awsClients, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		output, err := awsClients.ssmClient.UpdateDocument(ctx, &ssm.UpdateDocumentInput{
+			Name:            &name,
+			Content:         &manifest,
+			VersionName:     aws.String(d.Get(attVersionName).(string)),
+			DocumentVersion: aws.String("$LATEST"),
+			Attachments: []ssmtypes.AttachmentsSource{
+				{
+					Key:    ssmtypes.AttachmentsSourceKeySourceUrl,
+					Values: []string{getPackageSourceUrl(d)},
+				},
+			},
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = awsClients.ssmClient.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+			Name:            &name,
+			DocumentVersion: output.DocumentDescription.DocumentVersion,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(attManifest, manifest); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingDocument, name, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDistributorPackageRead(ctx, d, m)
+}
+
+func resourceDistributorPackageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDistributorPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDistributorPackageCreate,
+		ReadContext:   resourceDistributorPackageRead,
+		UpdateContext: resourceDistributorPackageUpdate,
+		DeleteContext: resourceDistributorPackageDelete,
+		CustomizeDiff: resourceDistributorPackageCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attVersionName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attFile: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attSource: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attPlatformName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attPlatformVersion: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "_any",
+						},
+						attArchitecture: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "_any",
+						},
+					},
+				},
+			},
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attTags: tagsSchema(),
+			attManifest: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLatestVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDefaultVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
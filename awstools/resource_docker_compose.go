@@ -0,0 +1,305 @@
+package awstools
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_docker_compose resource
+const (
+	attProjectName        string = "project_name"
+	attProjectDirectory   string = "project_directory"
+	attHealthCheckTimeout string = "health_check_timeout"
+	attDownOnDestroy      string = "down_on_destroy"
+)
+
+func getComposeProjectDirectory(d *schema.ResourceData) string {
+	if v, ok := d.GetOk(attProjectDirectory); ok {
+		return v.(string)
+	}
+
+	return "/opt/" + d.Get(attProjectName).(string)
+}
+
+// Builds shell script downloading the compose file, verifying its checksum and starting the project.
+// The script waits for all the containers to be running and healthy, containers without health check are considered healthy once running.
+func getComposeUpScript(d *schema.ResourceData, contentUrl string, content []byte) string {
+	hash := getContentSha256(content)
+
+	return strings.Join([]string{
+		"set -e",
+		"dir=" + shellQuote(getComposeProjectDirectory(d)),
+		"project=" + shellQuote(d.Get(attProjectName).(string)),
+		`mkdir -p "$dir"`,
+		`tmp=$(mktemp "$dir/.compose.XXXXXX")`,
+		`trap 'rm -f "$tmp"' EXIT`,
+		"curl -fsSL " + shellQuote(contentUrl) + ` -o "$tmp"`,
+		`echo "` + hash + `  $tmp" | sha256sum -c --quiet -`,
+		`mv -f "$tmp" "$dir/docker-compose.yml"`,
+		`docker compose -p "$project" -f "$dir/docker-compose.yml" up -d --remove-orphans`,
+		"deadline=$(($(date +%s) + " + strconv.Itoa(d.Get(attHealthCheckTimeout).(int)) + "))",
+		"while true; do",
+		"  pending=0",
+		`  for id in $(docker compose -p "$project" -f "$dir/docker-compose.yml" ps -aq); do`,
+		`    state=$(docker inspect -f '{{.Name}} {{.State.Status}}/{{if .State.Health}}{{.State.Health.Status}}{{end}}/{{.State.ExitCode}}' "$id")`,
+		`    case "${state#* }" in`,
+		"      running//*|running/healthy/*|exited/*/0) ;;",
+		"      running/starting/*|running/unhealthy/*|created/*|restarting/*) pending=1 ;;",
+		`      *) echo "Container $state failed" >&2; exit 1 ;;`,
+		"    esac",
+		"  done",
+		`  [ "$pending" -eq 0 ] && break`,
+		`  if [ "$(date +%s)" -ge "$deadline" ]; then`,
+		`    docker compose -p "$project" -f "$dir/docker-compose.yml" ps -a >&2`,
+		`    echo "Containers are not healthy" >&2`,
+		"    exit 1",
+		"  fi",
+		"  sleep 5",
+		"done",
+		`docker compose -p "$project" -f "$dir/docker-compose.yml" ps`,
+	}, "\n")
+}
+
+// Deploys the compose file to the target instances.
+// The project is deployed again when the content of the compose file changes.
+func resourceDockerComposeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	content, err := getFileContent(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	key := getContentSha256(content)
+	if prefix := d.Get(attS3KeyPrefix).(string); prefix != "" {
+		key = prefix + "/" + key
+	}
+
+	// The URL must stay valid while the command waits for the target instances.
+	expires := time.Duration(executionTimeout+waitTimeout) * time.Second
+
+	contentUrl, err := awsClients.stageObject(extendedCtx, d.Get(attS3BucketName).(string), key, content, expires)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The instances keep their copy of the compose file, the staged one is deleted once the command completes.
+	defer awsClients.deleteObject(ctx, d.Get(attS3BucketName).(string), key)
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {getComposeUpScript(d, contentUrl, content)},
+	}
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentRunShellScript, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	if err := d.Set(attContentSha256, getContentSha256(content)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceDockerComposeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceDockerComposeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceDockerComposeCreate(ctx, d, m)
+}
+
+// Stops and removes the containers of the project if requested.
+func resourceDockerComposeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if d.Get(attDownOnDestroy).(bool) {
+		executionTimeout := d.Get(attExecutionTimeout).(int)
+		comment := d.Get(attComment).(string)
+		outputLocation := getOutputLocation(d)
+
+		ssmParameters := map[string][]string{
+			ssmParameterCommands: {strings.Join([]string{
+				"set -e",
+				"dir=" + shellQuote(getComposeProjectDirectory(d)),
+				`if [ -f "$dir/docker-compose.yml" ]; then`,
+				"  docker compose -p " + shellQuote(d.Get(attProjectName).(string)) + ` -f "$dir/docker-compose.yml" down --remove-orphans`,
+				`  rm -f "$dir/docker-compose.yml"`,
+				"fi",
+			}, "\n")},
+		}
+
+		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		defer cancel()
+
+		_, err := awsClients.RunCommand(extendedCtx, &ssmDocumentRunShellScript, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDockerCompose() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &updateTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceDockerComposeCreate,
+		ReadContext:   resourceDockerComposeRead,
+		UpdateContext: resourceDockerComposeUpdate,
+		DeleteContext: resourceDockerComposeDelete,
+		CustomizeDiff: resourceFileCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			attProjectName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attContent: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{attContent, attSource},
+			},
+			attSource: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attProjectDirectory: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attHealthCheckTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+			attDownOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1800,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attContentSha256: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
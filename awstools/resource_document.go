@@ -0,0 +1,248 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_document resource
+const (
+	attContent        string = "content"
+	attDocumentType   string = "document_type"
+	attDocumentFormat string = "document_format"
+	attTargetType     string = "target_type"
+	attVersionName    string = "version_name"
+	attLatestVersion  string = "latest_version"
+	attDefaultVersion string = "default_version"
+)
+
+func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	input := &ssm.CreateDocumentInput{
+		Name:           &name,
+		Content:        aws.String(d.Get(attContent).(string)),
+		DocumentType:   ssmtypes.DocumentType(d.Get(attDocumentType).(string)),
+		DocumentFormat: ssmtypes.DocumentFormat(d.Get(attDocumentFormat).(string)),
+		Tags:           expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attTargetType); ok {
+		input.TargetType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attVersionName); ok {
+		input.VersionName = aws.String(v.(string))
+	}
+
+	_, err := awsClients.ssmClient.CreateDocument(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDocumentRead(ctx, d, m)
+}
+
+func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	document, err := awsClients.GetDocument(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, &ssm.GetDocumentInput{
+		Name:           document.Name,
+		DocumentFormat: document.DocumentFormat,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:           document.Name,
+		attContent:        output.Content,
+		attDocumentType:   document.DocumentType,
+		attDocumentFormat: document.DocumentFormat,
+		attTargetType:     document.TargetType,
+		attVersionName:    document.VersionName,
+		attLatestVersion:  document.LatestVersion,
+		attDefaultVersion: document.DefaultVersion,
+		attTags:           flattenTags(document.Tags),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	if d.HasChanges(attContent, attDocumentFormat, attTargetType, attVersionName) {
+		input := &ssm.UpdateDocumentInput{
+			Name:            &name,
+			Content:         aws.String(d.Get(attContent).(string)),
+			DocumentFormat:  ssmtypes.DocumentFormat(d.Get(attDocumentFormat).(string)),
+			DocumentVersion: aws.String("$LATEST"),
+		}
+
+		if v, ok := d.GetOk(attTargetType); ok {
+			input.TargetType = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attVersionName); ok {
+			input.VersionName = aws.String(v.(string))
+		}
+
+		output, err := awsClients.ssmClient.UpdateDocument(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := awsClients.waitForDocumentActive(ctx, name, documentWaitTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = awsClients.ssmClient.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+			Name:            &name,
+			DocumentVersion: output.DocumentDescription.DocumentVersion,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingDocument, name, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDocumentRead(ctx, d, m)
+}
+
+func resourceDocumentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDocumentCreate,
+		ReadContext:   resourceDocumentRead,
+		UpdateContext: resourceDocumentUpdate,
+		DeleteContext: resourceDocumentDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attContent: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			attDocumentType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(ssmtypes.DocumentTypeCommand),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.DocumentType("").Values()), false),
+			},
+			attDocumentFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.DocumentFormatJson),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.DocumentFormat("").Values()), false),
+			},
+			attTargetType: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attVersionName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTags: tagsSchema(),
+			attLatestVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attDefaultVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_document_permission resource
+const (
+	attAccountIds            string = "account_ids"
+	attSharedDocumentVersion string = "shared_document_version"
+)
+
+func resourceDocumentPermissionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+
+	input := &ssm.ModifyDocumentPermissionInput{
+		Name:            &name,
+		PermissionType:  ssmtypes.DocumentPermissionTypeShare,
+		AccountIdsToAdd: getStringSet(d, attAccountIds),
+	}
+
+	if v, ok := d.GetOk(attSharedDocumentVersion); ok {
+		input.SharedDocumentVersion = aws.String(v.(string))
+	}
+
+	_, err := awsClients.ssmClient.ModifyDocumentPermission(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	return resourceDocumentPermissionRead(ctx, d, m)
+}
+
+func resourceDocumentPermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	document, err := awsClients.GetDocument(ctx, name)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	var accountIds []string
+	var sharedDocumentVersion *string
+
+	input := &ssm.DescribeDocumentPermissionInput{
+		Name:           &name,
+		PermissionType: ssmtypes.DocumentPermissionTypeShare,
+	}
+
+	for {
+		output, err := awsClients.ssmClient.DescribeDocumentPermission(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accountIds = append(accountIds, output.AccountIds...)
+
+		for _, info := range output.AccountSharingInfoList {
+			if info.SharedDocumentVersion != nil {
+				sharedDocumentVersion = info.SharedDocumentVersion
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if len(accountIds) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(attName, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attAccountIds, accountIds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attSharedDocumentVersion, sharedDocumentVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDocumentPermissionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	oldAccounts, newAccounts := d.GetChange(attAccountIds)
+
+	input := &ssm.ModifyDocumentPermissionInput{
+		Name:               &name,
+		PermissionType:     ssmtypes.DocumentPermissionTypeShare,
+		AccountIdsToRemove: setToStrings(oldAccounts.(*schema.Set).Difference(newAccounts.(*schema.Set))),
+		AccountIdsToAdd:    setToStrings(newAccounts.(*schema.Set).Difference(oldAccounts.(*schema.Set))),
+	}
+
+	// Changing the shared version requires re-sharing the document with all the accounts.
+	if d.HasChange(attSharedDocumentVersion) {
+		input.AccountIdsToAdd = getStringSet(d, attAccountIds)
+	}
+
+	if v, ok := d.GetOk(attSharedDocumentVersion); ok {
+		input.SharedDocumentVersion = aws.String(v.(string))
+	}
+
+	_, err := awsClients.ssmClient.ModifyDocumentPermission(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDocumentPermissionRead(ctx, d, m)
+}
+
+func resourceDocumentPermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.ModifyDocumentPermission(ctx, &ssm.ModifyDocumentPermissionInput{
+		Name:               &name,
+		PermissionType:     ssmtypes.DocumentPermissionTypeShare,
+		AccountIdsToRemove: getStringSet(d, attAccountIds),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDocumentPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDocumentPermissionCreate,
+		ReadContext:   resourceDocumentPermissionRead,
+		UpdateContext: resourceDocumentPermissionUpdate,
+		DeleteContext: resourceDocumentPermissionDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attAccountIds: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attSharedDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
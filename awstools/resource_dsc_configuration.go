@@ -0,0 +1,256 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_dsc_configuration resource
+const (
+	attMofFiles                   string = "mof_files"
+	attOperationMode              string = "operation_mode"
+	attRebootBehavior             string = "reboot_behavior"
+	attModuleSourceBucketName     string = "module_source_bucket_name"
+	attAllowPSGalleryModuleSource string = "allow_ps_gallery_module_source"
+	attServicePath                string = "service_path"
+	attVerboseLogging             string = "verbose_logging"
+	attInstanceStatuses           string = "instance_statuses"
+)
+
+var ssmDocumentApplyDSCMofs = "AWS-ApplyDSCMofs"
+
+// AWS-ApplyDSCMofs parameters
+var dscParameterMofsToApply = "MofsToApply"
+var dscParameterServicePath = "ServicePath"
+var dscParameterMofOperationMode = "MofOperationMode"
+var dscParameterComplianceType = "ComplianceType"
+var dscParameterModuleSourceBucketName = "ModuleSourceBucketName"
+var dscParameterAllowPSGalleryModuleSource = "AllowPSGalleryModuleSource"
+var dscParameterRebootBehavior = "RebootBehavior"
+var dscParameterEnableVerboseLogging = "EnableVerboseLogging"
+
+func setDscConfiguration(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	statuses, err := awsClients.listCommandInvocationStatuses(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attStatus:           command.Status,
+		attRequestedTime:    command.RequestedDateTime.UTC().Format(time.RFC3339),
+		attInstanceStatuses: statuses,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Applies the MOF files on the target instances.
+// The compliance of the instances is reported with the compliance type.
+func resourceDscConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		dscParameterMofsToApply:                {strings.Join(getStringList(d, attMofFiles), ",")},
+		dscParameterServicePath:                {d.Get(attServicePath).(string)},
+		dscParameterMofOperationMode:           {d.Get(attOperationMode).(string)},
+		dscParameterComplianceType:             {d.Get(attComplianceType).(string)},
+		dscParameterAllowPSGalleryModuleSource: {documentBool(d.Get(attAllowPSGalleryModuleSource).(bool))},
+		dscParameterRebootBehavior:             {d.Get(attRebootBehavior).(string)},
+		dscParameterEnableVerboseLogging:       {documentBool(d.Get(attVerboseLogging).(bool))},
+	}
+
+	if v, ok := d.GetOk(attModuleSourceBucketName); ok {
+		ssmParameters[dscParameterModuleSourceBucketName] = []string{v.(string)}
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentApplyDSCMofs, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	return setDscConfiguration(ctx, awsClients, d, command)
+}
+
+func resourceDscConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setDscConfiguration(ctx, awsClients, d, command)
+}
+
+func resourceDscConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceDscConfigurationCreate(ctx, d, m)
+}
+
+func resourceDscConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDscConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceDscConfigurationCreate,
+		ReadContext:   resourceDscConfigurationRead,
+		UpdateContext: resourceDscConfigurationUpdate,
+		DeleteContext: resourceDscConfigurationDelete,
+		Schema: map[string]*schema.Schema{
+			attMofFiles: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attOperationMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Apply",
+				ValidateFunc: validation.StringInSlice([]string{"Apply", "Report"}, false),
+			},
+			attRebootBehavior: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AfterMof",
+				ValidateFunc: validation.StringInSlice([]string{"AfterMof", "Immediately", "Never"}, false),
+			},
+			attComplianceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Custom:DSC",
+			},
+			attModuleSourceBucketName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attAllowPSGalleryModuleSource: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attServicePath: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "awsdsc",
+			},
+			attVerboseLogging: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attInstanceStatuses: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
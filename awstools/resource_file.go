@@ -0,0 +1,319 @@
+package awstools
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_file resource
+const (
+	attDestination     string = "destination"
+	attOwner           string = "owner"
+	attFileMode        string = "mode"
+	attRemoveOnDestroy string = "remove_on_destroy"
+	attContentSha256   string = "content_sha256"
+)
+
+var ssmDocumentRunShellScript = "AWS-RunShellScript"
+var ssmParameterCommands = "commands"
+
+// Returns the inline content or the content of the local source file.
+func getFileContent(d interface{ Get(string) interface{} }) ([]byte, error) {
+	if source := d.Get(attSource).(string); source != "" {
+		return os.ReadFile(source)
+	}
+
+	return []byte(d.Get(attContent).(string)), nil
+}
+
+func getContentSha256(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// Changes of the source file are detected with the hash of the content.
+func resourceFileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(attContent) || !d.NewValueKnown(attSource) {
+		return d.SetNewComputed(attContentSha256)
+	}
+
+	content, err := getFileContent(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := getContentSha256(content); hash != d.Get(attContentSha256).(string) {
+		return d.SetNew(attContentSha256, hash)
+	}
+
+	return nil
+}
+
+// Builds shell script writing the file next to the destination, verifying its checksum and moving it to the destination.
+func getPutFileScript(d *schema.ResourceData, contentUrl string, content []byte) string {
+	hash := getContentSha256(content)
+
+	lines := []string{
+		"set -e",
+		"dest=" + shellQuote(d.Get(attDestination).(string)),
+		`mkdir -p "$(dirname "$dest")"`,
+		`tmp=$(mktemp "$(dirname "$dest")/.ssm_file.XXXXXX")`,
+		`trap 'rm -f "$tmp"' EXIT`,
+	}
+
+	if contentUrl != "" {
+		lines = append(lines, "curl -fsSL "+shellQuote(contentUrl)+` -o "$tmp"`)
+	} else {
+		lines = append(lines, "echo "+shellQuote(base64.StdEncoding.EncodeToString(content))+` | base64 -d > "$tmp"`)
+	}
+
+	lines = append(lines,
+		`echo "`+hash+`  $tmp" | sha256sum -c --quiet -`,
+		"chmod "+d.Get(attFileMode).(string)+` "$tmp"`,
+	)
+
+	if owner := d.Get(attOwner).(string); owner != "" {
+		lines = append(lines, "chown "+shellQuote(owner)+` "$tmp"`)
+	}
+
+	lines = append(lines,
+		`mv -f "$tmp" "$dest"`,
+		`echo "`+hash+`  $dest" | sha256sum -c --quiet -`,
+	)
+
+	return strings.Join(lines, "\n")
+}
+
+func setFileCommand(d *schema.ResourceData, status string, requestedTime string) diag.Diagnostics {
+	if err := d.Set(attStatus, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attRequestedTime, requestedTime); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := "Put file " + d.Get(attDestination).(string)
+
+	content, err := getFileContent(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	var contentUrl string
+
+	if v, ok := d.GetOk(attS3BucketName); ok {
+		key := getContentSha256(content)
+		if prefix := d.Get(attS3KeyPrefix).(string); prefix != "" {
+			key = prefix + "/" + key
+		}
+
+		// The URL must stay valid while the command waits for the target instances.
+		expires := time.Duration(executionTimeout+waitTimeout) * time.Second
+
+		contentUrl, err = awsClients.stageObject(extendedCtx, v.(string), key, content, expires)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The content is sensitive, it is deleted once the command completes.
+		defer awsClients.deleteObject(ctx, v.(string), key)
+	}
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {getPutFileScript(d, contentUrl, content)},
+	}
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentRunShellScript, ssmParameters, getTargets(d), &executionTimeout, &comment, nil, nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	if err := d.Set(attContentSha256, getContentSha256(content)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceFileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceFileCreate(ctx, d, m)
+}
+
+// Removes the file from the target instances if requested.
+func resourceFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if d.Get(attRemoveOnDestroy).(bool) {
+		executionTimeout := d.Get(attExecutionTimeout).(int)
+		destination := d.Get(attDestination).(string)
+		comment := "Remove file " + destination
+
+		ssmParameters := map[string][]string{
+			ssmParameterCommands: {"rm -f " + shellQuote(destination)},
+		}
+
+		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		defer cancel()
+
+		_, err := awsClients.RunCommand(extendedCtx, &ssmDocumentRunShellScript, ssmParameters, getTargets(d), &executionTimeout, &comment, nil, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceFile() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceFileCreate,
+		ReadContext:   resourceFileRead,
+		UpdateContext: resourceFileUpdate,
+		DeleteContext: resourceFileDelete,
+		CustomizeDiff: resourceFileCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			attDestination: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attContent: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{attContent, attSource},
+			},
+			attSource: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOwner: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attFileMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0644",
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-7]{3,4}$`), "must be an octal file mode"),
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attRemoveOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attContentSha256: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
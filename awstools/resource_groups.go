@@ -0,0 +1,125 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	rgtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SSM resource groups target keys
+var ssmTargetResourceGroupsName = ssmTargetResourceGroupsPrefix + "Name"
+var ssmTargetResourceGroupsResourceTypeFilters = ssmTargetResourceGroupsPrefix + "ResourceTypeFilters"
+
+// Resource types of the resource groups members which run SSM commands
+var resourceTypeEC2Instance = "AWS::EC2::Instance"
+var resourceTypeManagedInstance = "AWS::SSM::ManagedInstance"
+var resourceTypeAllSupported = "AWS::AllSupported"
+
+// Prefix of the Ids of the hybrid managed instances, which are not EC2 instances
+var managedInstanceIdPrefix = "mi-"
+
+// Maximum count of values of the SSM instance information filters
+const instanceInformationFilterSize = 50
+
+// Returns the Ids of the EC2 instances and of the managed instances members of the resource group.
+// The resource types of the members are restricted to resourceTypes, unless it is empty.
+func (clients AwsClients) listGroupInstanceIds(ctx context.Context, groupName string, resourceTypes []string) ([]string, error) {
+	var filterValues []string
+
+	for _, resourceType := range []string{resourceTypeEC2Instance, resourceTypeManagedInstance} {
+		if len(resourceTypes) == 0 || slices.Contains(resourceTypes, resourceType) || slices.Contains(resourceTypes, resourceTypeAllSupported) {
+			filterValues = append(filterValues, resourceType)
+		}
+	}
+
+	if len(filterValues) == 0 {
+		return nil, nil
+	}
+
+	var instanceIds []string
+
+	paginator := resourcegroups.NewListGroupResourcesPaginator(clients.resourceGroupsClient, &resourcegroups.ListGroupResourcesInput{
+		Group: &groupName,
+		Filters: []rgtypes.ResourceFilter{
+			{Name: rgtypes.ResourceFilterNameResourceType, Values: filterValues},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			log.Error(ctx, err.Error())
+			return nil, err
+		}
+
+		for _, item := range output.Resources {
+			if item.Identifier == nil || item.Identifier.ResourceArn == nil {
+				continue
+			}
+
+			// The resource of the ARN is instance/i-* or managed-instance/mi-*.
+			resourceArn, err := arn.Parse(*item.Identifier.ResourceArn)
+			if err != nil {
+				return nil, err
+			}
+
+			instanceIds = append(instanceIds, resourceArn.Resource[strings.LastIndex(resourceArn.Resource, "/")+1:])
+		}
+	}
+
+	log.Info(ctx, fmt.Sprintf("Resource group %s has %d target instances.", groupName, len(instanceIds)))
+
+	return instanceIds, nil
+}
+
+// Waits until the managed instances status is online.
+// Unlike waitForTargetInstances, the instances are not looked up in EC2, so that hybrid managed instances are waited for.
+func (clients AwsClients) waitForManagedInstances(ctx context.Context, instanceIds []string, deadline time.Time) error {
+	for polling := true; polling; polling = clients.waitForNextPoll(deadline) {
+		onlineInstanceCount := 0
+
+		for chunk := range slices.Chunk(instanceIds, instanceInformationFilterSize) {
+			paginator := ssm.NewDescribeInstanceInformationPaginator(clients.ssmClient, &ssm.DescribeInstanceInformationInput{
+				Filters: []ssmtypes.InstanceInformationStringFilter{
+					{Key: &ssmTargetInstanceIds, Values: chunk},
+				},
+			})
+
+			for paginator.HasMorePages() {
+				output, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					log.Error(ctx, err.Error())
+					return err
+				}
+
+				for _, instance := range output.InstanceInformationList {
+					if instance.PingStatus == ssmtypes.PingStatusOnline {
+						onlineInstanceCount += 1
+					}
+				}
+			}
+		}
+
+		log.Info(ctx, fmt.Sprintf("%d of %d managed instances are online.", onlineInstanceCount, len(instanceIds)))
+
+		if onlineInstanceCount == len(instanceIds) {
+			return nil
+		}
+	}
+
+	log.Error(ctx, "Managed instances are not online.")
+
+	return errors.New("managed instances are not online")
+}
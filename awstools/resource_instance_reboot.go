@@ -0,0 +1,247 @@
+package awstools
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_instance_reboot resource
+const (
+	attHealthCheck          string = "health_check"
+	attTriggers             string = "triggers"
+	attHealthCheckCommandId string = "health_check_command_id"
+)
+
+// Builds the script requesting the reboot from SSM Agent with the reboot exit code.
+// SSM Agent runs the script again after the reboot, the marker file makes the second run succeed.
+func getRebootScript(interpreter string) string {
+	marker := ".ssm_instance_reboot_" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	if interpreter == interpreterPowerShell {
+		return strings.Join([]string{
+			"$marker = Join-Path $env:TEMP " + powershellQuote(marker),
+			"if (Test-Path $marker) { Remove-Item -Force $marker; exit 0 }",
+			"New-Item -ItemType File -Path $marker | Out-Null",
+			"exit 3010",
+		}, "\n")
+	}
+
+	return strings.Join([]string{
+		"marker=/var/tmp/" + marker,
+		`if [ -f "$marker" ]; then rm -f "$marker"; exit 0; fi`,
+		`touch "$marker"`,
+		"exit 194",
+	}, "\n")
+}
+
+func setInstanceReboot(d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	values := map[string]interface{}{
+		attStatus:        command.Status,
+		attRequestedTime: command.RequestedDateTime.UTC().Format(time.RFC3339),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Reboots the target instances and waits for SSM Agent to report back online.
+// Runs the health check command on the instances afterwards if requested.
+func resourceInstanceRebootCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	interpreter := d.Get(attInterpreter).(string)
+	documentName := getScriptDocumentName(interpreter)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {getRebootScript(interpreter)},
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(2*executionTimeout+waitTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	instanceIds, err := awsClients.listCommandInstanceIds(extendedCtx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := awsClients.waitForAgentVersion(extendedCtx, instanceIds, "", waitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var healthCheckCommandId string
+
+	if healthCheck := d.Get(attHealthCheck).(string); healthCheck != "" {
+		ssmParameters := map[string][]string{
+			ssmParameterCommands: {healthCheck},
+		}
+
+		healthCheckCommand, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		healthCheckCommandId = *healthCheckCommand.CommandId
+	}
+
+	if err := d.Set(attHealthCheckCommandId, healthCheckCommandId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setInstanceReboot(d, command)
+}
+
+func resourceInstanceRebootRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setInstanceReboot(d, command)
+}
+
+func resourceInstanceRebootUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceInstanceRebootCreate(ctx, d, m)
+}
+
+func resourceInstanceRebootDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceInstanceReboot() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceInstanceRebootCreate,
+		ReadContext:   resourceInstanceRebootRead,
+		UpdateContext: resourceInstanceRebootUpdate,
+		DeleteContext: resourceInstanceRebootDelete,
+		Schema: map[string]*schema.Schema{
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attInterpreter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      interpreterShell,
+				ValidateFunc: validation.StringInSlice([]string{interpreterShell, interpreterPowerShell}, false),
+			},
+			attHealthCheck: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1800,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attHealthCheckCommandId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
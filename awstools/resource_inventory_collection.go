@@ -0,0 +1,278 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_inventory_collection resource
+const (
+	attCategories string = "categories"
+)
+
+var ssmDocumentGatherSoftwareInventory = "AWS-GatherSoftwareInventory"
+
+// AWS-GatherSoftwareInventory parameters enabling the inventory categories
+var inventoryCategories = []string{
+	"applications",
+	"awsComponents",
+	"billingInfo",
+	"customInventory",
+	"instanceDetailedInformation",
+	"networkConfig",
+	"services",
+	"windowsRoles",
+	"windowsUpdates",
+}
+
+// Every category is either enabled or disabled when the categories are specified.
+func getInventoryParameters(d *schema.ResourceData) map[string][]string {
+	v, ok := d.GetOk(attCategories)
+
+	if !ok {
+		return nil
+	}
+
+	categories := setToStrings(v.(*schema.Set))
+	parameters := make(map[string][]string)
+
+	for _, category := range inventoryCategories {
+		parameters[category] = []string{"Disabled"}
+	}
+
+	for _, category := range categories {
+		parameters[category] = []string{"Enabled"}
+	}
+
+	return parameters
+}
+
+func flattenInventoryCategories(parameters map[string][]string) []string {
+	var categories []string
+
+	for _, category := range inventoryCategories {
+		if values, ok := parameters[category]; ok && len(values) > 0 && values[0] == "Enabled" {
+			categories = append(categories, category)
+		}
+	}
+
+	sort.Strings(categories)
+
+	return categories
+}
+
+func resourceInventoryCollectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreateAssociationInput{
+		Name:               &ssmDocumentGatherSoftwareInventory,
+		Parameters:         getInventoryParameters(d),
+		Targets:            getTargets(d),
+		ScheduleExpression: aws.String(d.Get(attScheduleExpression).(string)),
+		OutputLocation:     getAssociationOutputLocation(d),
+		Tags:               expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attAssociationName); ok {
+		input.AssociationName = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.CreateAssociation(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.AssociationDescription.AssociationId)
+
+	return resourceInventoryCollectionRead(ctx, d, m)
+}
+
+func resourceInventoryCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	associationId := d.Id()
+
+	output, err := awsClients.ssmClient.DescribeAssociation(ctx, &ssm.DescribeAssociationInput{
+		AssociationId: &associationId,
+	})
+
+	var notFound *ssmtypes.AssociationDoesNotExist
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association := output.AssociationDescription
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingAssociation, associationId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attAssociationId:      association.AssociationId,
+		attAssociationName:    association.AssociationName,
+		attTargets:            flattenTargets(association.Targets),
+		attScheduleExpression: association.ScheduleExpression,
+		attOutputLocation:     flattenAssociationOutputLocation(association.OutputLocation),
+		attTags:               tags,
+	}
+
+	if len(association.Parameters) > 0 {
+		values[attCategories] = flattenInventoryCategories(association.Parameters)
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceInventoryCollectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	associationId := d.Id()
+
+	if d.HasChangesExcept(attTags) {
+		input := &ssm.UpdateAssociationInput{
+			AssociationId:      &associationId,
+			Name:               &ssmDocumentGatherSoftwareInventory,
+			Parameters:         getInventoryParameters(d),
+			Targets:            getTargets(d),
+			ScheduleExpression: aws.String(d.Get(attScheduleExpression).(string)),
+			OutputLocation:     getAssociationOutputLocation(d),
+		}
+
+		if v, ok := d.GetOk(attAssociationName); ok {
+			input.AssociationName = aws.String(v.(string))
+		}
+
+		_, err := awsClients.ssmClient.UpdateAssociation(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingAssociation, associationId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInventoryCollectionRead(ctx, d, m)
+}
+
+func resourceInventoryCollectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceAssociationDelete(ctx, d, m)
+}
+
+func resourceInventoryCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInventoryCollectionCreate,
+		ReadContext:   resourceInventoryCollectionRead,
+		UpdateContext: resourceInventoryCollectionUpdate,
+		DeleteContext: resourceInventoryCollectionDelete,
+		Schema: map[string]*schema.Schema{
+			attAssociationName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attScheduleExpression: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "rate(30 minutes)",
+			},
+			attCategories: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(inventoryCategories, false),
+				},
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						attS3Region: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+			attAssociationId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
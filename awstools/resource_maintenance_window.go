@@ -0,0 +1,287 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_maintenance_window resource
+const (
+	attSchedule                 string = "schedule"
+	attDuration                 string = "duration"
+	attCutoff                   string = "cutoff"
+	attScheduleTimezone         string = "schedule_timezone"
+	attScheduleOffset           string = "schedule_offset"
+	attAllowUnassociatedTargets string = "allow_unassociated_targets"
+	attEnabled                  string = "enabled"
+	attStartDate                string = "start_date"
+	attEndDate                  string = "end_date"
+)
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreateMaintenanceWindowInput{
+		Name:                     aws.String(d.Get(attName).(string)),
+		Schedule:                 aws.String(d.Get(attSchedule).(string)),
+		Duration:                 aws.Int32(int32(d.Get(attDuration).(int))),
+		Cutoff:                   int32(d.Get(attCutoff).(int)),
+		AllowUnassociatedTargets: d.Get(attAllowUnassociatedTargets).(bool),
+		Tags:                     expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attScheduleTimezone); ok {
+		input.ScheduleTimezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attScheduleOffset); ok {
+		input.ScheduleOffset = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(attStartDate); ok {
+		input.StartDate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attEndDate); ok {
+		input.EndDate = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.CreateMaintenanceWindow(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.WindowId)
+
+	// Maintenance windows are created enabled.
+	if !d.Get(attEnabled).(bool) {
+		_, err := awsClients.ssmClient.UpdateMaintenanceWindow(ctx, &ssm.UpdateMaintenanceWindowInput{
+			WindowId: output.WindowId,
+			Enabled:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Id()
+
+	window, err := awsClients.ssmClient.GetMaintenanceWindow(ctx, &ssm.GetMaintenanceWindowInput{
+		WindowId: &windowId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:                     window.Name,
+		attDescription:              window.Description,
+		attSchedule:                 window.Schedule,
+		attDuration:                 window.Duration,
+		attCutoff:                   window.Cutoff,
+		attScheduleTimezone:         window.ScheduleTimezone,
+		attScheduleOffset:           window.ScheduleOffset,
+		attAllowUnassociatedTargets: window.AllowUnassociatedTargets,
+		attEnabled:                  window.Enabled,
+		attStartDate:                window.StartDate,
+		attEndDate:                  window.EndDate,
+		attTags:                     tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Id()
+
+	if d.HasChangesExcept(attTags) {
+		// Replace the window settings so that the removed optional settings are cleared.
+		input := &ssm.UpdateMaintenanceWindowInput{
+			WindowId:                 &windowId,
+			Name:                     aws.String(d.Get(attName).(string)),
+			Schedule:                 aws.String(d.Get(attSchedule).(string)),
+			Duration:                 aws.Int32(int32(d.Get(attDuration).(int))),
+			Cutoff:                   aws.Int32(int32(d.Get(attCutoff).(int))),
+			AllowUnassociatedTargets: aws.Bool(d.Get(attAllowUnassociatedTargets).(bool)),
+			Enabled:                  aws.Bool(d.Get(attEnabled).(bool)),
+			Replace:                  aws.Bool(true),
+		}
+
+		if v, ok := d.GetOk(attDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attScheduleTimezone); ok {
+			input.ScheduleTimezone = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attScheduleOffset); ok {
+			input.ScheduleOffset = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk(attStartDate); ok {
+			input.StartDate = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attEndDate); ok {
+			input.EndDate = aws.String(v.(string))
+		}
+
+		_, err := awsClients.ssmClient.UpdateMaintenanceWindow(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMaintenanceWindowRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteMaintenanceWindow(ctx, &ssm.DeleteMaintenanceWindowInput{
+		WindowId: &windowId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attSchedule: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDuration: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 24),
+			},
+			attCutoff: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			attScheduleTimezone: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attScheduleOffset: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+			attAllowUnassociatedTargets: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attStartDate: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attEndDate: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTags: tagsSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
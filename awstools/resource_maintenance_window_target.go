@@ -0,0 +1,252 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_maintenance_window_target resource
+const (
+	attWindowId         string = "window_id"
+	attWindowTargetId   string = "window_target_id"
+	attResourceType     string = "resource_type"
+	attOwnerInformation string = "owner_information"
+)
+
+// SSM maintenance window filter keys
+var ssmWindowFilterWindowTargetId = "WindowTargetId"
+
+func resourceMaintenanceWindowTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Get(attWindowId).(string)
+
+	input := &ssm.RegisterTargetWithMaintenanceWindowInput{
+		WindowId:     &windowId,
+		ResourceType: ssmtypes.MaintenanceWindowResourceType(d.Get(attResourceType).(string)),
+		Targets:      getTargets(d),
+	}
+
+	if v, ok := d.GetOk(attName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attOwnerInformation); ok {
+		input.OwnerInformation = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.RegisterTargetWithMaintenanceWindow(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(windowId + "/" + *output.WindowTargetId)
+
+	return resourceMaintenanceWindowTargetRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := awsClients.ssmClient.DescribeMaintenanceWindowTargets(ctx, &ssm.DescribeMaintenanceWindowTargetsInput{
+		WindowId: &ids[0],
+		Filters: []ssmtypes.MaintenanceWindowFilter{
+			{
+				Key:    &ssmWindowFilterWindowTargetId,
+				Values: []string{ids[1]},
+			},
+		},
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(output.Targets) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	target := output.Targets[0]
+
+	values := map[string]interface{}{
+		attWindowId:         target.WindowId,
+		attWindowTargetId:   target.WindowTargetId,
+		attResourceType:     target.ResourceType,
+		attTargets:          flattenTargets(target.Targets),
+		attName:             target.Name,
+		attDescription:      target.Description,
+		attOwnerInformation: target.OwnerInformation,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceMaintenanceWindowTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &ssm.UpdateMaintenanceWindowTargetInput{
+		WindowId:       &ids[0],
+		WindowTargetId: &ids[1],
+		Targets:        getTargets(d),
+		Replace:        aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk(attName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attOwnerInformation); ok {
+		input.OwnerInformation = aws.String(v.(string))
+	}
+
+	_, err = awsClients.ssmClient.UpdateMaintenanceWindowTarget(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMaintenanceWindowTargetRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.DeregisterTargetFromMaintenanceWindow(ctx, &ssm.DeregisterTargetFromMaintenanceWindowInput{
+		WindowId:       &ids[0],
+		WindowTargetId: &ids[1],
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceMaintenanceWindowTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowTargetCreate,
+		ReadContext:   resourceMaintenanceWindowTargetRead,
+		UpdateContext: resourceMaintenanceWindowTargetUpdate,
+		DeleteContext: resourceMaintenanceWindowTargetDelete,
+		Schema: map[string]*schema.Schema{
+			attWindowId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attResourceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(ssmtypes.MaintenanceWindowResourceTypeInstance),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.MaintenanceWindowResourceType("").Values()), false),
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOwnerInformation: {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			attWindowTargetId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,489 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_maintenance_window_task resource
+const (
+	attWindowTaskId             string = "window_task_id"
+	attTaskType                 string = "task_type"
+	attTaskArn                  string = "task_arn"
+	attServiceRoleArn           string = "service_role_arn"
+	attPriority                 string = "priority"
+	attCutoffBehavior           string = "cutoff_behavior"
+	attTaskInvocationParameters string = "task_invocation_parameters"
+	attRunCommandParameters     string = "run_command_parameters"
+	attAutomationParameters     string = "automation_parameters"
+	attTimeoutSeconds           string = "timeout_seconds"
+)
+
+const (
+	runCommandParametersKey = attTaskInvocationParameters + ".0." + attRunCommandParameters + ".0."
+	automationParametersKey = attTaskInvocationParameters + ".0." + attAutomationParameters + ".0."
+)
+
+func getTaskInvocationParameters(d *schema.ResourceData) *ssmtypes.MaintenanceWindowTaskInvocationParameters {
+	if _, ok := d.GetOk(attTaskInvocationParameters); !ok {
+		return nil
+	}
+
+	parameters := &ssmtypes.MaintenanceWindowTaskInvocationParameters{}
+
+	if _, ok := d.GetOk(attTaskInvocationParameters + ".0." + attRunCommandParameters); ok {
+		runCommand := &ssmtypes.MaintenanceWindowRunCommandParameters{
+			Parameters: getParameters(d, runCommandParametersKey+attParameters),
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attComment); ok {
+			runCommand.Comment = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attDocumentVersion); ok {
+			runCommand.DocumentVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attTimeoutSeconds); ok {
+			runCommand.TimeoutSeconds = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attServiceRoleArn); ok {
+			runCommand.ServiceRoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attOutputLocation + ".0." + attS3BucketName); ok {
+			runCommand.OutputS3BucketName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(runCommandParametersKey + attOutputLocation + ".0." + attS3KeyPrefix); ok {
+			runCommand.OutputS3KeyPrefix = aws.String(v.(string))
+		}
+
+		parameters.RunCommand = runCommand
+	}
+
+	if _, ok := d.GetOk(attTaskInvocationParameters + ".0." + attAutomationParameters); ok {
+		automation := &ssmtypes.MaintenanceWindowAutomationParameters{
+			Parameters: getParameters(d, automationParametersKey+attParameters),
+		}
+
+		if v, ok := d.GetOk(automationParametersKey + attDocumentVersion); ok {
+			automation.DocumentVersion = aws.String(v.(string))
+		}
+
+		parameters.Automation = automation
+	}
+
+	return parameters
+}
+
+func flattenTaskInvocationParameters(d *schema.ResourceData, parameters *ssmtypes.MaintenanceWindowTaskInvocationParameters) []interface{} {
+	if parameters == nil {
+		return nil
+	}
+
+	block := map[string]interface{}{}
+
+	if runCommand := parameters.RunCommand; runCommand != nil {
+		runCommandBlock := map[string]interface{}{
+			attComment:         aws.ToString(runCommand.Comment),
+			attDocumentVersion: aws.ToString(runCommand.DocumentVersion),
+			attTimeoutSeconds:  int(aws.ToInt32(runCommand.TimeoutSeconds)),
+			attServiceRoleArn:  aws.ToString(runCommand.ServiceRoleArn),
+			attParameters:      flattenParameters(d, runCommandParametersKey+attParameters, runCommand.Parameters),
+		}
+
+		if runCommand.OutputS3BucketName != nil {
+			runCommandBlock[attOutputLocation] = []interface{}{
+				map[string]interface{}{
+					attS3BucketName: aws.ToString(runCommand.OutputS3BucketName),
+					attS3KeyPrefix:  aws.ToString(runCommand.OutputS3KeyPrefix),
+				},
+			}
+		}
+
+		block[attRunCommandParameters] = []interface{}{runCommandBlock}
+	}
+
+	if automation := parameters.Automation; automation != nil {
+		block[attAutomationParameters] = []interface{}{
+			map[string]interface{}{
+				attDocumentVersion: aws.ToString(automation.DocumentVersion),
+				attParameters:      flattenParameters(d, automationParametersKey+attParameters, automation.Parameters),
+			},
+		}
+	}
+
+	return []interface{}{block}
+}
+
+func resourceMaintenanceWindowTaskCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Get(attWindowId).(string)
+
+	input := &ssm.RegisterTaskWithMaintenanceWindowInput{
+		WindowId:                 &windowId,
+		TaskType:                 ssmtypes.MaintenanceWindowTaskType(d.Get(attTaskType).(string)),
+		TaskArn:                  aws.String(d.Get(attTaskArn).(string)),
+		Targets:                  getTargets(d),
+		Priority:                 aws.Int32(int32(d.Get(attPriority).(int))),
+		CutoffBehavior:           ssmtypes.MaintenanceWindowTaskCutoffBehavior(d.Get(attCutoffBehavior).(string)),
+		TaskInvocationParameters: getTaskInvocationParameters(d),
+	}
+
+	if v, ok := d.GetOk(attServiceRoleArn); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.RegisterTaskWithMaintenanceWindow(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(windowId + "/" + *output.WindowTaskId)
+
+	return resourceMaintenanceWindowTaskRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowTaskRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := awsClients.ssmClient.GetMaintenanceWindowTask(ctx, &ssm.GetMaintenanceWindowTaskInput{
+		WindowId:     &ids[0],
+		WindowTaskId: &ids[1],
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attWindowId:                 task.WindowId,
+		attWindowTaskId:             task.WindowTaskId,
+		attTaskType:                 task.TaskType,
+		attTaskArn:                  task.TaskArn,
+		attTargets:                  flattenTargets(task.Targets),
+		attServiceRoleArn:           task.ServiceRoleArn,
+		attPriority:                 task.Priority,
+		attMaxConcurrency:           task.MaxConcurrency,
+		attMaxErrors:                task.MaxErrors,
+		attCutoffBehavior:           task.CutoffBehavior,
+		attName:                     task.Name,
+		attDescription:              task.Description,
+		attTaskInvocationParameters: flattenTaskInvocationParameters(d, task.TaskInvocationParameters),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceMaintenanceWindowTaskUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &ssm.UpdateMaintenanceWindowTaskInput{
+		WindowId:                 &ids[0],
+		WindowTaskId:             &ids[1],
+		TaskArn:                  aws.String(d.Get(attTaskArn).(string)),
+		Targets:                  getTargets(d),
+		Priority:                 aws.Int32(int32(d.Get(attPriority).(int))),
+		CutoffBehavior:           ssmtypes.MaintenanceWindowTaskCutoffBehavior(d.Get(attCutoffBehavior).(string)),
+		TaskInvocationParameters: getTaskInvocationParameters(d),
+		Replace:                  aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk(attServiceRoleArn); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = awsClients.ssmClient.UpdateMaintenanceWindowTask(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMaintenanceWindowTaskRead(ctx, d, m)
+}
+
+func resourceMaintenanceWindowTaskDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.DeregisterTaskFromMaintenanceWindow(ctx, &ssm.DeregisterTaskFromMaintenanceWindowInput{
+		WindowId:     &ids[0],
+		WindowTaskId: &ids[1],
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func maintenanceWindowTaskParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				attValues: {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMaintenanceWindowTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowTaskCreate,
+		ReadContext:   resourceMaintenanceWindowTaskRead,
+		UpdateContext: resourceMaintenanceWindowTaskUpdate,
+		DeleteContext: resourceMaintenanceWindowTaskDelete,
+		Schema: map[string]*schema.Schema{
+			attWindowId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attTaskType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ssmtypes.MaintenanceWindowTaskTypeRunCommand),
+					string(ssmtypes.MaintenanceWindowTaskTypeAutomation),
+				}, false),
+			},
+			attTaskArn: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attServiceRoleArn: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attCutoffBehavior: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.MaintenanceWindowTaskCutoffBehaviorContinueTask),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.MaintenanceWindowTaskCutoffBehavior("").Values()), false),
+			},
+			attName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTaskInvocationParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attRunCommandParameters: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attComment: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									attDocumentVersion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									attTimeoutSeconds: {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									attServiceRoleArn: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									attParameters: maintenanceWindowTaskParametersSchema(),
+									attOutputLocation: {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												attS3BucketName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												attS3KeyPrefix: {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						attAutomationParameters: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									attDocumentVersion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									attParameters: maintenanceWindowTaskParametersSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			attWindowTaskId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
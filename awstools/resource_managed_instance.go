@@ -0,0 +1,253 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_managed_instance resource
+const (
+	attInstanceId       string = "instance_id"
+	attPingStatus       string = "ping_status"
+	attPlatformType     string = "platform_type"
+	attPlatformName     string = "platform_name"
+	attPlatformVersion  string = "platform_version"
+	attAgentVersion     string = "agent_version"
+	attComputerName     string = "computer_name"
+	attIpAddress        string = "ip_address"
+	attRegistrationDate string = "registration_date"
+)
+
+func resourceManagedInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Get(attInstanceId).(string)
+
+	instance, err := awsClients.GetInstanceInformation(ctx, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if instance.InstanceId == nil {
+		return diag.FromErr(fmt.Errorf("managed instance %s not found", instanceId))
+	}
+
+	d.SetId(instanceId)
+
+	if v, ok := d.GetOk(attIamRole); ok {
+		iamRole := v.(string)
+
+		_, err := awsClients.ssmClient.UpdateManagedInstanceRole(ctx, &ssm.UpdateManagedInstanceRoleInput{
+			InstanceId: &instanceId,
+			IamRole:    &iamRole,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk(attTags); ok {
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingManagedInstance, instanceId, map[string]interface{}{}, v.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceManagedInstanceRead(ctx, d, m)
+}
+
+func resourceManagedInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Id()
+
+	instance, err := awsClients.GetInstanceInformation(ctx, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if instance.InstanceId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingManagedInstance, instanceId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var registrationDate string
+	if instance.RegistrationDate != nil {
+		registrationDate = instance.RegistrationDate.UTC().Format(time.RFC3339)
+	}
+
+	values := map[string]interface{}{
+		attInstanceId:       instance.InstanceId,
+		attIamRole:          instance.IamRole,
+		attName:             instance.Name,
+		attActivationId:     instance.ActivationId,
+		attPingStatus:       instance.PingStatus,
+		attPlatformType:     instance.PlatformType,
+		attPlatformName:     instance.PlatformName,
+		attPlatformVersion:  instance.PlatformVersion,
+		attAgentVersion:     instance.AgentVersion,
+		attComputerName:     instance.ComputerName,
+		attIpAddress:        instance.IPAddress,
+		attRegistrationDate: registrationDate,
+		attTags:             tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceManagedInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Id()
+
+	if d.HasChange(attIamRole) {
+		iamRole := d.Get(attIamRole).(string)
+
+		_, err := awsClients.ssmClient.UpdateManagedInstanceRole(ctx, &ssm.UpdateManagedInstanceRoleInput{
+			InstanceId: &instanceId,
+			IamRole:    &iamRole,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingManagedInstance, instanceId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceManagedInstanceRead(ctx, d, m)
+}
+
+func resourceManagedInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	instanceId := d.Id()
+
+	_, err := awsClients.ssmClient.DeregisterManagedInstance(ctx, &ssm.DeregisterManagedInstanceInput{
+		InstanceId: &instanceId,
+	})
+
+	var notFound *ssmtypes.InvalidInstanceId
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceManagedInstanceCreate,
+		ReadContext:   resourceManagedInstanceRead,
+		UpdateContext: resourceManagedInstanceUpdate,
+		DeleteContext: resourceManagedInstanceDelete,
+		Schema: map[string]*schema.Schema{
+			attInstanceId: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^mi-[0-9a-f]{17}$`), "must be an Id of hybrid managed instance"),
+			},
+			attIamRole: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attTags: tagsSchema(),
+			attName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attActivationId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPingStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPlatformVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attAgentVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attComputerName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attIpAddress: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRegistrationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
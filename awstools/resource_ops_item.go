@@ -0,0 +1,433 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_ops_item resource
+const (
+	attTitle            string = "title"
+	attSource           string = "source"
+	attSeverity         string = "severity"
+	attCategory         string = "category"
+	attOpsItemType      string = "ops_item_type"
+	attOperationalData  string = "operational_data"
+	attRelatedResources string = "related_resources"
+	attRelatedOpsItems  string = "related_ops_items"
+)
+
+// Operational data key of the resources related to OpsItem
+const opsItemResourcesKey = "/aws/resources"
+
+type OpsItemResource struct {
+	Arn string `json:"arn"`
+}
+
+func getOperationalData(d *schema.ResourceData) (map[string]ssmtypes.OpsItemDataValue, error) {
+	operationalData := make(map[string]ssmtypes.OpsItemDataValue)
+
+	for _, o := range d.Get(attOperationalData).(*schema.Set).List() {
+		data := o.(map[string]interface{})
+		operationalData[data[attKey].(string)] = ssmtypes.OpsItemDataValue{
+			Value: aws.String(data[attValue].(string)),
+			Type:  ssmtypes.OpsItemDataType(data[attType].(string)),
+		}
+	}
+
+	if arns := getStringSet(d, attRelatedResources); len(arns) > 0 {
+		var resources []OpsItemResource
+		for _, arn := range arns {
+			resources = append(resources, OpsItemResource{Arn: arn})
+		}
+
+		bytes, err := json.Marshal(resources)
+
+		if err != nil {
+			return nil, err
+		}
+
+		operationalData[opsItemResourcesKey] = ssmtypes.OpsItemDataValue{
+			Value: aws.String(string(bytes)),
+			Type:  ssmtypes.OpsItemDataTypeSearchableString,
+		}
+	}
+
+	return operationalData, nil
+}
+
+func flattenOperationalData(operationalData map[string]ssmtypes.OpsItemDataValue) ([]interface{}, []string, error) {
+	var data []interface{}
+	var arns []string
+
+	for _, key := range sortedKeys(operationalData) {
+		value := operationalData[key]
+
+		if key == opsItemResourcesKey {
+			var resources []OpsItemResource
+			if err := json.Unmarshal([]byte(aws.ToString(value.Value)), &resources); err != nil {
+				return nil, nil, err
+			}
+
+			for _, resource := range resources {
+				arns = append(arns, resource.Arn)
+			}
+
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			attKey:   key,
+			attValue: aws.ToString(value.Value),
+			attType:  string(value.Type),
+		})
+	}
+
+	sort.Strings(arns)
+
+	return data, arns, nil
+}
+
+func getRelatedOpsItems(d *schema.ResourceData) []ssmtypes.RelatedOpsItem {
+	relatedOpsItems := []ssmtypes.RelatedOpsItem{}
+
+	for _, opsItemId := range getStringSet(d, attRelatedOpsItems) {
+		relatedOpsItems = append(relatedOpsItems, ssmtypes.RelatedOpsItem{OpsItemId: aws.String(opsItemId)})
+	}
+
+	return relatedOpsItems
+}
+
+// Returns the operational data keys of the previous resource state.
+func getOldOperationalDataKeys(d *schema.ResourceData) []string {
+	var keys []string
+
+	oldData, _ := d.GetChange(attOperationalData)
+
+	for _, o := range oldData.(*schema.Set).List() {
+		keys = append(keys, o.(map[string]interface{})[attKey].(string))
+	}
+
+	if oldResources, _ := d.GetChange(attRelatedResources); oldResources.(*schema.Set).Len() > 0 {
+		keys = append(keys, opsItemResourcesKey)
+	}
+
+	return keys
+}
+
+func resourceOpsItemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	operationalData, err := getOperationalData(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &ssm.CreateOpsItemInput{
+		Title:           aws.String(d.Get(attTitle).(string)),
+		Description:     aws.String(d.Get(attDescription).(string)),
+		Source:          aws.String(d.Get(attSource).(string)),
+		OperationalData: operationalData,
+		RelatedOpsItems: getRelatedOpsItems(d),
+		Tags:            expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attSeverity); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attPriority); ok {
+		input.Priority = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(attCategory); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attOpsItemType); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	output, err := awsClients.ssmClient.CreateOpsItem(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.OpsItemId)
+
+	// OpsItems are created open.
+	if v, ok := d.GetOk(attStatus); ok && v.(string) != string(ssmtypes.OpsItemStatusOpen) {
+		_, err := awsClients.ssmClient.UpdateOpsItem(ctx, &ssm.UpdateOpsItemInput{
+			OpsItemId: output.OpsItemId,
+			Status:    ssmtypes.OpsItemStatus(v.(string)),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOpsItemRead(ctx, d, m)
+}
+
+func resourceOpsItemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsItemId := d.Id()
+
+	output, err := awsClients.ssmClient.GetOpsItem(ctx, &ssm.GetOpsItemInput{
+		OpsItemId: &opsItemId,
+	})
+
+	var notFound *ssmtypes.OpsItemNotFoundException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opsItem := output.OpsItem
+
+	operationalData, relatedResources, err := flattenOperationalData(opsItem.OperationalData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var relatedOpsItems []string
+	for _, relatedOpsItem := range opsItem.RelatedOpsItems {
+		relatedOpsItems = append(relatedOpsItems, aws.ToString(relatedOpsItem.OpsItemId))
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingOpsItem, opsItemId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attTitle:            opsItem.Title,
+		attDescription:      opsItem.Description,
+		attSource:           opsItem.Source,
+		attSeverity:         opsItem.Severity,
+		attPriority:         opsItem.Priority,
+		attCategory:         opsItem.Category,
+		attStatus:           opsItem.Status,
+		attOpsItemType:      opsItem.OpsItemType,
+		attOperationalData:  operationalData,
+		attRelatedResources: relatedResources,
+		attRelatedOpsItems:  relatedOpsItems,
+		attArn:              opsItem.OpsItemArn,
+		attTags:             tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceOpsItemUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsItemId := d.Id()
+
+	if d.HasChangesExcept(attTags) {
+		operationalData, err := getOperationalData(d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId:       &opsItemId,
+			Title:           aws.String(d.Get(attTitle).(string)),
+			Description:     aws.String(d.Get(attDescription).(string)),
+			OperationalData: operationalData,
+			RelatedOpsItems: getRelatedOpsItems(d),
+			Status:          ssmtypes.OpsItemStatus(d.Get(attStatus).(string)),
+		}
+
+		if v, ok := d.GetOk(attSeverity); ok {
+			input.Severity = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attPriority); ok {
+			input.Priority = aws.Int32(int32(v.(int)))
+		}
+
+		if v, ok := d.GetOk(attCategory); ok {
+			input.Category = aws.String(v.(string))
+		}
+
+		// Operational data removed from the configuration is deleted from OpsItem.
+		for _, key := range getOldOperationalDataKeys(d) {
+			if _, ok := operationalData[key]; !ok {
+				input.OperationalDataToDelete = append(input.OperationalDataToDelete, key)
+			}
+		}
+
+		_, err = awsClients.ssmClient.UpdateOpsItem(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingOpsItem, opsItemId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOpsItemRead(ctx, d, m)
+}
+
+func resourceOpsItemDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsItemId := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteOpsItem(ctx, &ssm.DeleteOpsItemInput{
+		OpsItemId: &opsItemId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsItemCreate,
+		ReadContext:   resourceOpsItemRead,
+		UpdateContext: resourceOpsItemUpdate,
+		DeleteContext: resourceOpsItemDelete,
+		Schema: map[string]*schema.Schema{
+			attTitle: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attSource: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attSeverity: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+			},
+			attPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			attCategory: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OpsItemStatus("").Values()), false),
+			},
+			attOpsItemType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			attOperationalData: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(ssmtypes.OpsItemDataTypeSearchableString),
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OpsItemDataType("").Values()), false),
+						},
+					},
+				},
+			},
+			attRelatedResources: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attRelatedOpsItems: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attTags: tagsSchema(),
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
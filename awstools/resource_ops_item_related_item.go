@@ -0,0 +1,172 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_ops_item_related_item resource
+const (
+	attOpsItemId       string = "ops_item_id"
+	attAssociationType string = "association_type"
+	attResourceUri     string = "resource_uri"
+)
+
+func resourceOpsItemRelatedItemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsItemId := d.Get(attOpsItemId).(string)
+
+	output, err := awsClients.ssmClient.AssociateOpsItemRelatedItem(ctx, &ssm.AssociateOpsItemRelatedItemInput{
+		OpsItemId:       &opsItemId,
+		AssociationType: aws.String(d.Get(attAssociationType).(string)),
+		ResourceType:    aws.String(d.Get(attResourceType).(string)),
+		ResourceUri:     aws.String(d.Get(attResourceUri).(string)),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(opsItemId + "/" + *output.AssociationId)
+
+	return resourceOpsItemRelatedItemRead(ctx, d, m)
+}
+
+func resourceOpsItemRelatedItemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opsItemId, associationId := ids[0], ids[1]
+
+	output, err := awsClients.ssmClient.ListOpsItemRelatedItems(ctx, &ssm.ListOpsItemRelatedItemsInput{
+		OpsItemId: &opsItemId,
+		Filters: []ssmtypes.OpsItemRelatedItemsFilter{
+			{
+				Key:      ssmtypes.OpsItemRelatedItemsFilterKeyAssociationId,
+				Operator: ssmtypes.OpsItemRelatedItemsFilterOperatorEqual,
+				Values:   []string{associationId},
+			},
+		},
+	})
+
+	var notFound *ssmtypes.OpsItemNotFoundException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(output.Summaries) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	item := output.Summaries[0]
+
+	values := map[string]interface{}{
+		attOpsItemId:       item.OpsItemId,
+		attAssociationId:   item.AssociationId,
+		attAssociationType: item.AssociationType,
+		attResourceType:    item.ResourceType,
+		attResourceUri:     item.ResourceUri,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceOpsItemRelatedItemDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.DisassociateOpsItemRelatedItem(ctx, &ssm.DisassociateOpsItemRelatedItemInput{
+		OpsItemId:     &ids[0],
+		AssociationId: &ids[1],
+	})
+
+	var associationNotFound *ssmtypes.OpsItemRelatedItemAssociationNotFoundException
+	var opsItemNotFound *ssmtypes.OpsItemNotFoundException
+	if err != nil && !errors.As(err, &associationNotFound) && !errors.As(err, &opsItemNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOpsItemRelatedItem() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsItemRelatedItemCreate,
+		ReadContext:   resourceOpsItemRelatedItemRead,
+		DeleteContext: resourceOpsItemRelatedItemDelete,
+		Schema: map[string]*schema.Schema{
+			attOpsItemId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attAssociationType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "RelatesTo",
+			},
+			attResourceType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attResourceUri: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attAssociationId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
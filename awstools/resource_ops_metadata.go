@@ -0,0 +1,255 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_ops_metadata resource
+const (
+	attResourceId string = "resource_id"
+	attMetadata   string = "metadata"
+)
+
+func expandMetadata(metadata map[string]interface{}) map[string]ssmtypes.MetadataValue {
+	values := make(map[string]ssmtypes.MetadataValue)
+
+	for key, value := range metadata {
+		values[key] = ssmtypes.MetadataValue{Value: aws.String(value.(string))}
+	}
+
+	return values
+}
+
+// Tagging API identifies OpsMetadata by the part of its ARN following "opsmetadata".
+func getOpsMetadataTagId(opsMetadataArn string) (string, error) {
+	_, tagId, found := strings.Cut(opsMetadataArn, ":opsmetadata")
+
+	if !found {
+		return "", fmt.Errorf("invalid OpsMetadata ARN %s", opsMetadataArn)
+	}
+
+	return tagId, nil
+}
+
+// Retrieves all metadata of SSM OpsMetadata.
+// Returns nil resource Id if OpsMetadata does not exist.
+func (clients AwsClients) getOpsMetadata(ctx context.Context, opsMetadataArn string) (*string, map[string]string, error) {
+	var resourceId *string
+	metadata := make(map[string]string)
+
+	input := &ssm.GetOpsMetadataInput{
+		OpsMetadataArn: &opsMetadataArn,
+	}
+
+	for {
+		output, err := clients.ssmClient.GetOpsMetadata(ctx, input)
+
+		var notFound *ssmtypes.OpsMetadataNotFoundException
+		if errors.As(err, &notFound) {
+			return nil, nil, nil
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resourceId = output.ResourceId
+
+		for key, value := range output.Metadata {
+			metadata[key] = aws.ToString(value.Value)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return resourceId, metadata, nil
+}
+
+func resourceOpsMetadataCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	output, err := awsClients.ssmClient.CreateOpsMetadata(ctx, &ssm.CreateOpsMetadataInput{
+		ResourceId: aws.String(d.Get(attResourceId).(string)),
+		Metadata:   expandMetadata(d.Get(attMetadata).(map[string]interface{})),
+		Tags:       expandTags(d.Get(attTags).(map[string]interface{})),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.OpsMetadataArn)
+
+	return resourceOpsMetadataRead(ctx, d, m)
+}
+
+func resourceOpsMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsMetadataArn := d.Id()
+
+	resourceId, metadata, err := awsClients.getOpsMetadata(ctx, opsMetadataArn)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resourceId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	tagId, err := getOpsMetadataTagId(opsMetadataArn)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingOpsmetadata, tagId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attResourceId: resourceId,
+		attMetadata:   metadata,
+		attArn:        opsMetadataArn,
+		attTags:       tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceOpsMetadataUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsMetadataArn := d.Id()
+
+	if d.HasChange(attMetadata) {
+		oldMetadata, newMetadata := d.GetChange(attMetadata)
+
+		input := &ssm.UpdateOpsMetadataInput{
+			OpsMetadataArn: &opsMetadataArn,
+		}
+
+		for key := range oldMetadata.(map[string]interface{}) {
+			if _, ok := newMetadata.(map[string]interface{})[key]; !ok {
+				input.KeysToDelete = append(input.KeysToDelete, key)
+			}
+		}
+
+		if metadata := newMetadata.(map[string]interface{}); len(metadata) > 0 {
+			input.MetadataToUpdate = expandMetadata(metadata)
+		}
+
+		_, err := awsClients.ssmClient.UpdateOpsMetadata(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		tagId, err := getOpsMetadataTagId(opsMetadataArn)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingOpsmetadata, tagId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOpsMetadataRead(ctx, d, m)
+}
+
+func resourceOpsMetadataDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	opsMetadataArn := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteOpsMetadata(ctx, &ssm.DeleteOpsMetadataInput{
+		OpsMetadataArn: &opsMetadataArn,
+	})
+
+	var notFound *ssmtypes.OpsMetadataNotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOpsMetadata() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsMetadataCreate,
+		ReadContext:   resourceOpsMetadataRead,
+		UpdateContext: resourceOpsMetadataUpdate,
+		DeleteContext: resourceOpsMetadataDelete,
+		Schema: map[string]*schema.Schema{
+			attResourceId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attMetadata: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attTags: tagsSchema(),
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
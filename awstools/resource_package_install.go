@@ -0,0 +1,295 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_package_install resource
+const (
+	attAction              string = "action"
+	attInstallationType    string = "installation_type"
+	attAdditionalArguments string = "additional_arguments"
+	attUninstallOnDestroy  string = "uninstall_on_destroy"
+	attInstalledVersions   string = "installed_versions"
+)
+
+var ssmDocumentConfigureAWSPackage = "AWS-ConfigureAWSPackage"
+
+// AWS-ConfigureAWSPackage parameters
+var packageParameterAction = "action"
+var packageParameterInstallationType = "installationType"
+var packageParameterName = "name"
+var packageParameterVersion = "version"
+var packageParameterAdditionalArguments = "additionalArguments"
+
+// Package installation result, e.g. "Successfully installed arn:aws:ssm:::package/AmazonCloudWatchAgent 1.300032.2b361"
+var packageInstalledRegexp = regexache.MustCompile(`Successfully installed \S+ (\S+)`)
+
+// Parses the installed package versions from the outputs of the command invocations.
+func parseInstalledVersions(outputs map[string]string) map[string]string {
+	versions := make(map[string]string)
+
+	for instanceId, output := range outputs {
+		if match := packageInstalledRegexp.FindStringSubmatch(output); match != nil {
+			versions[instanceId] = match[1]
+		}
+	}
+
+	return versions
+}
+
+// Runs AWS-ConfigureAWSPackage document with the action on the target instances.
+func runPackageCommand(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, action string) (ssmtypes.Command, error) {
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		packageParameterAction:           {action},
+		packageParameterInstallationType: {d.Get(attInstallationType).(string)},
+		packageParameterName:             {d.Get(attName).(string)},
+	}
+
+	if v, ok := d.GetOk(attVersion); ok && action == "Install" {
+		ssmParameters[packageParameterVersion] = []string{v.(string)}
+	}
+
+	if v, ok := d.GetOk(attAdditionalArguments); ok {
+		additionalArguments, err := json.Marshal(v)
+
+		if err != nil {
+			return ssmtypes.Command{}, err
+		}
+
+		ssmParameters[packageParameterAdditionalArguments] = []string{string(additionalArguments)}
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	return awsClients.RunCommand(extendedCtx, &ssmDocumentConfigureAWSPackage, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+}
+
+func setPackageInstall(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	statuses, err := awsClients.listCommandInvocationStatuses(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attStatus:           command.Status,
+		attRequestedTime:    command.RequestedDateTime.UTC().Format(time.RFC3339),
+		attInstanceStatuses: statuses,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Installs or uninstalls the package on the target instances.
+// The installed versions are parsed from the outputs of the command invocations.
+func resourcePackageInstallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := runPackageCommand(ctx, awsClients, d, d.Get(attAction).(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	outputs, err := awsClients.listCommandOutputs(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attInstalledVersions, parseInstalledVersions(outputs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setPackageInstall(ctx, awsClients, d, command)
+}
+
+func resourcePackageInstallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setPackageInstall(ctx, awsClients, d, command)
+}
+
+func resourcePackageInstallUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourcePackageInstallCreate(ctx, d, m)
+}
+
+// Uninstalls the package from the target instances if requested.
+func resourcePackageInstallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if d.Get(attUninstallOnDestroy).(bool) && d.Get(attAction).(string) == "Install" {
+		if _, err := runPackageCommand(ctx, awsClients, d, "Uninstall"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePackageInstall() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &updateTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourcePackageInstallCreate,
+		ReadContext:   resourcePackageInstallRead,
+		UpdateContext: resourcePackageInstallUpdate,
+		DeleteContext: resourcePackageInstallDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Install",
+				ValidateFunc: validation.StringInSlice([]string{"Install", "Uninstall"}, false),
+			},
+			attInstallationType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Uninstall and reinstall",
+				ValidateFunc: validation.StringInSlice([]string{"Uninstall and reinstall", "In-place update"}, false),
+			},
+			attAdditionalArguments: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attUninstallOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1800,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attInstanceStatuses: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attInstalledVersions: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,318 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_parameter resource
+const (
+	attType           string = "type"
+	attValue          string = "value"
+	attDescription    string = "description"
+	attTier           string = "tier"
+	attKmsKeyId       string = "kms_key_id"
+	attAllowedPattern string = "allowed_pattern"
+	attDataType       string = "data_type"
+	attOverwrite      string = "overwrite"
+	attWithDecryption string = "with_decryption"
+	attVersion        string = "version"
+	attArn            string = "arn"
+)
+
+func getPutParameterInput(d *schema.ResourceData) (*ssm.PutParameterInput, error) {
+	input := &ssm.PutParameterInput{
+		Name:           aws.String(d.Get(attName).(string)),
+		Type:           ssmtypes.ParameterType(d.Get(attType).(string)),
+		Value:          aws.String(d.Get(attValue).(string)),
+		Description:    aws.String(d.Get(attDescription).(string)),
+		AllowedPattern: aws.String(d.Get(attAllowedPattern).(string)),
+	}
+
+	if v, ok := d.GetOk(attTier); ok {
+		input.Tier = ssmtypes.ParameterTier(v.(string))
+	}
+
+	if v, ok := d.GetOk(attKmsKeyId); ok && input.Type == ssmtypes.ParameterTypeSecureString {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDataType); ok {
+		input.DataType = aws.String(v.(string))
+	}
+
+	policies, err := getParameterPolicies(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if policies != "" {
+		input.Policies = &policies
+	} else if d.HasChanges(attExpirationPolicy, attExpirationNotificationPolicy, attNoChangeNotificationPolicy) {
+		// Empty list detaches all the policies from the parameter.
+		input.Policies = aws.String("[]")
+	}
+
+	return input, nil
+}
+
+func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Get(attName).(string)
+	overwrite := d.Get(attOverwrite).(bool)
+	tags := d.Get(attTags).(map[string]interface{})
+
+	input, err := getPutParameterInput(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input.Overwrite = &overwrite
+
+	// Tags cannot be specified when an existing parameter is overwritten.
+	if !overwrite {
+		input.Tags = expandTags(tags)
+	}
+
+	_, err = awsClients.ssmClient.PutParameter(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+
+	if overwrite && len(tags) > 0 {
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingParameter, name, map[string]interface{}{}, tags)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceParameterRead(ctx, d, m)
+}
+
+func resourceParameterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+	withDecryption := d.Get(attWithDecryption).(bool)
+
+	parameter, err := awsClients.GetParameter(ctx, name, withDecryption)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if parameter.Name == nil {
+		d.SetId("")
+		return diags
+	}
+
+	metadata, err := awsClients.describeParameter(ctx, name)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingParameter, name)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := flattenParameterPolicies(metadata.Policies)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:           parameter.Name,
+		attType:           parameter.Type,
+		attDescription:    metadata.Description,
+		attTier:           metadata.Tier,
+		attKmsKeyId:       metadata.KeyId,
+		attAllowedPattern: metadata.AllowedPattern,
+		attDataType:       parameter.DataType,
+		attVersion:        parameter.Version,
+		attArn:            parameter.ARN,
+		attTags:           tags,
+	}
+
+	for key, value := range policies {
+		values[key] = value
+	}
+
+	// SecureString value is only refreshed when the decryption is requested.
+	if parameter.Type != ssmtypes.ParameterTypeSecureString || withDecryption {
+		values[attValue] = parameter.Value
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	if d.HasChangesExcept(attTags, attOverwrite, attWithDecryption) {
+		input, err := getPutParameterInput(d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.Overwrite = aws.Bool(true)
+
+		_, err = awsClients.ssmClient.PutParameter(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingParameter, name, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceParameterRead(ctx, d, m)
+}
+
+func resourceParameterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.ParameterNotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceParameter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceParameterCreate,
+		ReadContext:   resourceParameterRead,
+		UpdateContext: resourceParameterUpdate,
+		DeleteContext: resourceParameterDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ParameterType("").Values()), false),
+			},
+			attValue: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attTier: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ParameterTier("").Values()), false),
+				// Intelligent-Tiering parameters are reported as either Standard or Advanced.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != "" && new == string(ssmtypes.ParameterTierIntelligentTiering)
+				},
+			},
+			attKmsKeyId: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attAllowedPattern: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDataType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attOverwrite: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attWithDecryption: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attExpirationPolicy:             parameterPolicySchema(attExpirationPolicy),
+			attExpirationNotificationPolicy: parameterPolicySchema(attExpirationNotificationPolicy),
+			attNoChangeNotificationPolicy:   parameterPolicySchema(attNoChangeNotificationPolicy),
+			attTags:                         tagsSchema(),
+			attVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
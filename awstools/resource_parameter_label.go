@@ -0,0 +1,190 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_parameter_label resource
+const (
+	attLabel    string = "label"
+	attSelector string = "selector"
+)
+
+// Splits the Id of the label, the parameter name and the label separated by ':' as in the parameter selectors.
+func parseParameterLabelId(id string) (string, string, error) {
+	index := strings.LastIndex(id, ":")
+
+	if index <= 0 || index == len(id)-1 {
+		return "", "", fmt.Errorf("unexpected format of Id (%s), expected parameter name and label separated by ':'", id)
+	}
+
+	return id[:index], id[index+1:], nil
+}
+
+// Attaches the label to the parameter version, the latest version if no version is specified.
+// SSM moves the label when it is already attached to another version of the parameter.
+func labelParameterVersion(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData) error {
+	input := &ssm.LabelParameterVersionInput{
+		Name:   aws.String(d.Get(attName).(string)),
+		Labels: []string{d.Get(attLabel).(string)},
+	}
+
+	if v, ok := d.GetOk(attVersion); ok {
+		input.ParameterVersion = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := awsClients.ssmClient.LabelParameterVersion(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if len(output.InvalidLabels) > 0 {
+		return fmt.Errorf("invalid parameter labels: %s", strings.Join(output.InvalidLabels, ", "))
+	}
+
+	return nil
+}
+
+func resourceParameterLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if err := labelParameterVersion(ctx, awsClients, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get(attName).(string) + ":" + d.Get(attLabel).(string))
+
+	return resourceParameterLabelRead(ctx, d, m)
+}
+
+func resourceParameterLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name, label, err := parseParameterLabelId(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version, err := awsClients.getParameterLabelVersion(ctx, name, label)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if version == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	values := map[string]interface{}{
+		attName:     name,
+		attLabel:    label,
+		attVersion:  version,
+		attSelector: d.Id(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// Moves the label to the new version of the parameter.
+func resourceParameterLabelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if err := labelParameterVersion(ctx, awsClients, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceParameterLabelRead(ctx, d, m)
+}
+
+func resourceParameterLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name, label, err := parseParameterLabelId(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.UnlabelParameterVersion(ctx, &ssm.UnlabelParameterVersionInput{
+		Name:             &name,
+		ParameterVersion: aws.Int64(int64(d.Get(attVersion).(int))),
+		Labels:           []string{label},
+	})
+
+	var parameterNotFound *ssmtypes.ParameterNotFound
+	var versionNotFound *ssmtypes.ParameterVersionNotFound
+	if err != nil && !errors.As(err, &parameterNotFound) && !errors.As(err, &versionNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceParameterLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceParameterLabelCreate,
+		ReadContext:   resourceParameterLabelRead,
+		UpdateContext: resourceParameterLabelUpdate,
+		DeleteContext: resourceParameterLabelDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attLabel: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attVersion: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			attSelector: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,455 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_patch_baseline resource
+const (
+	attOperatingSystem                  string = "operating_system"
+	attGlobalFilters                    string = "global_filters"
+	attApprovalRules                    string = "approval_rules"
+	attPatchFilters                     string = "patch_filters"
+	attApproveAfterDays                 string = "approve_after_days"
+	attApproveUntilDate                 string = "approve_until_date"
+	attComplianceLevel                  string = "compliance_level"
+	attEnableNonSecurity                string = "enable_non_security"
+	attApprovedPatches                  string = "approved_patches"
+	attApprovedPatchesComplianceLevel   string = "approved_patches_compliance_level"
+	attApprovedPatchesEnableNonSecurity string = "approved_patches_enable_non_security"
+	attRejectedPatches                  string = "rejected_patches"
+	attRejectedPatchesAction            string = "rejected_patches_action"
+	attSources                          string = "sources"
+	attProducts                         string = "products"
+	attConfiguration                    string = "configuration"
+)
+
+func expandPatchFilterGroup(filters []interface{}) *ssmtypes.PatchFilterGroup {
+	group := &ssmtypes.PatchFilterGroup{
+		PatchFilters: []ssmtypes.PatchFilter{},
+	}
+
+	for _, f := range filters {
+		filter := f.(map[string]interface{})
+		var values []string
+		for _, value := range filter[attValues].([]interface{}) {
+			values = append(values, value.(string))
+		}
+		group.PatchFilters = append(group.PatchFilters, ssmtypes.PatchFilter{
+			Key:    ssmtypes.PatchFilterKey(filter[attKey].(string)),
+			Values: values,
+		})
+	}
+
+	return group
+}
+
+func flattenPatchFilterGroup(group *ssmtypes.PatchFilterGroup) []interface{} {
+	var filters []interface{}
+
+	if group == nil {
+		return filters
+	}
+
+	for _, filter := range group.PatchFilters {
+		filters = append(filters, map[string]interface{}{
+			attKey:    string(filter.Key),
+			attValues: filter.Values,
+		})
+	}
+
+	return filters
+}
+
+func expandApprovalRules(rules []interface{}) *ssmtypes.PatchRuleGroup {
+	group := &ssmtypes.PatchRuleGroup{
+		PatchRules: []ssmtypes.PatchRule{},
+	}
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		patchRule := ssmtypes.PatchRule{
+			PatchFilterGroup:  expandPatchFilterGroup(rule[attPatchFilters].([]interface{})),
+			ComplianceLevel:   ssmtypes.PatchComplianceLevel(rule[attComplianceLevel].(string)),
+			EnableNonSecurity: aws.Bool(rule[attEnableNonSecurity].(bool)),
+		}
+
+		if v := rule[attApproveUntilDate].(string); v != "" {
+			patchRule.ApproveUntilDate = aws.String(v)
+		} else {
+			patchRule.ApproveAfterDays = aws.Int32(int32(rule[attApproveAfterDays].(int)))
+		}
+
+		group.PatchRules = append(group.PatchRules, patchRule)
+	}
+
+	return group
+}
+
+func flattenApprovalRules(group *ssmtypes.PatchRuleGroup) []interface{} {
+	var rules []interface{}
+
+	if group == nil {
+		return rules
+	}
+
+	for _, rule := range group.PatchRules {
+		rules = append(rules, map[string]interface{}{
+			attPatchFilters:      flattenPatchFilterGroup(rule.PatchFilterGroup),
+			attApproveAfterDays:  int(aws.ToInt32(rule.ApproveAfterDays)),
+			attApproveUntilDate:  aws.ToString(rule.ApproveUntilDate),
+			attComplianceLevel:   string(rule.ComplianceLevel),
+			attEnableNonSecurity: aws.ToBool(rule.EnableNonSecurity),
+		})
+	}
+
+	return rules
+}
+
+func expandPatchSources(sources []interface{}) []ssmtypes.PatchSource {
+	var patchSources []ssmtypes.PatchSource
+
+	for _, s := range sources {
+		source := s.(map[string]interface{})
+		var products []string
+		for _, product := range source[attProducts].([]interface{}) {
+			products = append(products, product.(string))
+		}
+		patchSources = append(patchSources, ssmtypes.PatchSource{
+			Name:          aws.String(source[attName].(string)),
+			Products:      products,
+			Configuration: aws.String(source[attConfiguration].(string)),
+		})
+	}
+
+	return patchSources
+}
+
+func flattenPatchSources(patchSources []ssmtypes.PatchSource) []interface{} {
+	var sources []interface{}
+
+	for _, source := range patchSources {
+		sources = append(sources, map[string]interface{}{
+			attName:          aws.ToString(source.Name),
+			attProducts:      source.Products,
+			attConfiguration: aws.ToString(source.Configuration),
+		})
+	}
+
+	return sources
+}
+
+func resourcePatchBaselineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreatePatchBaselineInput{
+		Name:                             aws.String(d.Get(attName).(string)),
+		OperatingSystem:                  ssmtypes.OperatingSystem(d.Get(attOperatingSystem).(string)),
+		ApprovedPatches:                  getStringSet(d, attApprovedPatches),
+		ApprovedPatchesComplianceLevel:   ssmtypes.PatchComplianceLevel(d.Get(attApprovedPatchesComplianceLevel).(string)),
+		ApprovedPatchesEnableNonSecurity: aws.Bool(d.Get(attApprovedPatchesEnableNonSecurity).(bool)),
+		RejectedPatches:                  getStringSet(d, attRejectedPatches),
+		RejectedPatchesAction:            ssmtypes.PatchAction(d.Get(attRejectedPatchesAction).(string)),
+		Sources:                          expandPatchSources(d.Get(attSources).([]interface{})),
+		Tags:                             expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attGlobalFilters); ok {
+		input.GlobalFilters = expandPatchFilterGroup(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(attApprovalRules); ok {
+		input.ApprovalRules = expandApprovalRules(v.([]interface{}))
+	}
+
+	output, err := awsClients.ssmClient.CreatePatchBaseline(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.BaselineId)
+
+	return resourcePatchBaselineRead(ctx, d, m)
+}
+
+func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Id()
+
+	baseline, err := awsClients.ssmClient.GetPatchBaseline(ctx, &ssm.GetPatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingPatchBaseline, baselineId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:                             baseline.Name,
+		attDescription:                      baseline.Description,
+		attOperatingSystem:                  baseline.OperatingSystem,
+		attGlobalFilters:                    flattenPatchFilterGroup(baseline.GlobalFilters),
+		attApprovalRules:                    flattenApprovalRules(baseline.ApprovalRules),
+		attApprovedPatches:                  baseline.ApprovedPatches,
+		attApprovedPatchesComplianceLevel:   baseline.ApprovedPatchesComplianceLevel,
+		attApprovedPatchesEnableNonSecurity: baseline.ApprovedPatchesEnableNonSecurity,
+		attRejectedPatches:                  baseline.RejectedPatches,
+		attRejectedPatchesAction:            baseline.RejectedPatchesAction,
+		attSources:                          flattenPatchSources(baseline.Sources),
+		attTags:                             tags,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Id()
+
+	if d.HasChangesExcept(attTags) {
+		input := &ssm.UpdatePatchBaselineInput{
+			BaselineId:                       &baselineId,
+			Name:                             aws.String(d.Get(attName).(string)),
+			Description:                      aws.String(d.Get(attDescription).(string)),
+			GlobalFilters:                    expandPatchFilterGroup(d.Get(attGlobalFilters).([]interface{})),
+			ApprovalRules:                    expandApprovalRules(d.Get(attApprovalRules).([]interface{})),
+			ApprovedPatches:                  getStringSet(d, attApprovedPatches),
+			ApprovedPatchesComplianceLevel:   ssmtypes.PatchComplianceLevel(d.Get(attApprovedPatchesComplianceLevel).(string)),
+			ApprovedPatchesEnableNonSecurity: aws.Bool(d.Get(attApprovedPatchesEnableNonSecurity).(bool)),
+			RejectedPatches:                  getStringSet(d, attRejectedPatches),
+			RejectedPatchesAction:            ssmtypes.PatchAction(d.Get(attRejectedPatchesAction).(string)),
+			Sources:                          expandPatchSources(d.Get(attSources).([]interface{})),
+			Replace:                          aws.Bool(true),
+		}
+
+		_, err := awsClients.ssmClient.UpdatePatchBaseline(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingPatchBaseline, baselineId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePatchBaselineRead(ctx, d, m)
+}
+
+func resourcePatchBaselineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Id()
+
+	_, err := awsClients.ssmClient.DeletePatchBaseline(ctx, &ssm.DeletePatchBaselineInput{
+		BaselineId: &baselineId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func patchFiltersSchema(required bool, maxItems int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: maxItems,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				attKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PatchFilterKey("").Values()), false),
+				},
+				attValues: {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePatchBaseline() *schema.Resource {
+	complianceLevels := enumValues(ssmtypes.PatchComplianceLevel("").Values())
+
+	return &schema.Resource{
+		CreateContext: resourcePatchBaselineCreate,
+		ReadContext:   resourcePatchBaselineRead,
+		UpdateContext: resourcePatchBaselineUpdate,
+		DeleteContext: resourcePatchBaselineDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attOperatingSystem: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(ssmtypes.OperatingSystemWindows),
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.OperatingSystem("").Values()), false),
+			},
+			attGlobalFilters: patchFiltersSchema(false, 4),
+			attApprovalRules: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attPatchFilters: patchFiltersSchema(true, 10),
+						attApproveAfterDays: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 360),
+						},
+						attApproveUntilDate: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attComplianceLevel: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(ssmtypes.PatchComplianceLevelUnspecified),
+							ValidateFunc: validation.StringInSlice(complianceLevels, false),
+						},
+						attEnableNonSecurity: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			attApprovedPatches: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attApprovedPatchesComplianceLevel: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ssmtypes.PatchComplianceLevelUnspecified),
+				ValidateFunc: validation.StringInSlice(complianceLevels, false),
+			},
+			attApprovedPatchesEnableNonSecurity: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attRejectedPatches: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			attRejectedPatchesAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.PatchAction("").Values()), false),
+			},
+			attSources: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attProducts: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attConfiguration: {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
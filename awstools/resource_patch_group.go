@@ -0,0 +1,162 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_patch_group resource
+const (
+	attBaselineId string = "baseline_id"
+	attPatchGroup string = "patch_group"
+)
+
+// SSM patch group filter keys
+var ssmPatchGroupFilterNamePrefix = "NAME_PREFIX"
+
+func resourcePatchGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	baselineId := d.Get(attBaselineId).(string)
+	patchGroup := d.Get(attPatchGroup).(string)
+
+	_, err := awsClients.ssmClient.RegisterPatchBaselineForPatchGroup(ctx, &ssm.RegisterPatchBaselineForPatchGroupInput{
+		BaselineId: &baselineId,
+		PatchGroup: &patchGroup,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Patch group names may contain '/', so the baseline Id goes first.
+	d.SetId(baselineId + "/" + patchGroup)
+
+	return resourcePatchGroupRead(ctx, d, m)
+}
+
+func resourcePatchGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	baselineId, patchGroup := ids[0], ids[1]
+
+	input := &ssm.DescribePatchGroupsInput{
+		Filters: []ssmtypes.PatchOrchestratorFilter{
+			{
+				Key:    &ssmPatchGroupFilterNamePrefix,
+				Values: []string{patchGroup},
+			},
+		},
+	}
+
+	found := false
+
+	for !found {
+		output, err := awsClients.ssmClient.DescribePatchGroups(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, mapping := range output.Mappings {
+			if aws.ToString(mapping.PatchGroup) == patchGroup && mapping.BaselineIdentity != nil &&
+				aws.ToString(mapping.BaselineIdentity.BaselineId) == baselineId {
+				found = true
+				break
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if !found {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(attBaselineId, baselineId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attPatchGroup, patchGroup); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourcePatchGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	ids, err := parseResourceId(d.Id(), 2)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = awsClients.ssmClient.DeregisterPatchBaselineForPatchGroup(ctx, &ssm.DeregisterPatchBaselineForPatchGroupInput{
+		BaselineId: &ids[0],
+		PatchGroup: &ids[1],
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePatchGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePatchGroupCreate,
+		ReadContext:   resourcePatchGroupRead,
+		DeleteContext: resourcePatchGroupDelete,
+		Schema: map[string]*schema.Schema{
+			attBaselineId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attPatchGroup: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
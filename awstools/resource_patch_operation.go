@@ -0,0 +1,310 @@
+package awstools
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_patch_scan and ssm_patch_install resources
+const (
+	attRebootOption        string = "reboot_option"
+	attPatchSummary        string = "patch_summary"
+	attInstalledCount      string = "installed_count"
+	attInstalledOtherCount string = "installed_other_count"
+	attMissingCount        string = "missing_count"
+	attFailedCount         string = "failed_count"
+	attNotApplicableCount  string = "not_applicable_count"
+	attOperationEndTime    string = "operation_end_time"
+)
+
+var ssmDocumentRunPatchBaseline = "AWS-RunPatchBaseline"
+
+// AWS-RunPatchBaseline parameters
+var ssmPatchParameterOperation = "Operation"
+var ssmPatchParameterRebootOption = "RebootOption"
+
+// Patch operations
+const (
+	patchOperationScan    = "Scan"
+	patchOperationInstall = "Install"
+)
+
+func flattenPatchStates(patchStates []ssmtypes.InstancePatchState) []interface{} {
+	var summaries []interface{}
+
+	for _, state := range patchStates {
+		var operationEndTime string
+		if state.OperationEndTime != nil {
+			operationEndTime = state.OperationEndTime.UTC().Format(time.RFC3339)
+		}
+
+		summaries = append(summaries, map[string]interface{}{
+			attInstanceId:          aws.ToString(state.InstanceId),
+			attInstalledCount:      int(state.InstalledCount),
+			attInstalledOtherCount: int(state.InstalledOtherCount),
+			attMissingCount:        int(state.MissingCount),
+			attFailedCount:         int(state.FailedCount),
+			attNotApplicableCount:  int(state.NotApplicableCount),
+			attOperationEndTime:    operationEndTime,
+		})
+	}
+
+	return summaries
+}
+
+func setPatchCommand(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	instanceIds, err := awsClients.listCommandInstanceIds(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patchStates, err := awsClients.describeInstancePatchStates(ctx, instanceIds)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attStatus:        command.Status,
+		attRequestedTime: command.RequestedDateTime.UTC().Format(time.RFC3339),
+		attPatchSummary:  flattenPatchStates(patchStates),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Runs AWS-RunPatchBaseline with the operation.
+// Sends the command without waiting for the target instances and the command invocations if the completion is not awaited.
+func resourcePatchOperationCreate(operation string) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		awsClients, ok := m.(*AwsClients)
+		if !ok {
+			return diag.Errorf("meta argument should be of type *AwsClients")
+		}
+
+		executionTimeout := d.Get(attExecutionTimeout).(int)
+		comment := d.Get(attComment).(string)
+		ssmTargets := getTargets(d)
+		outputLocation := getOutputLocation(d)
+
+		ssmParameters := map[string][]string{
+			ssmPatchParameterOperation: {operation},
+		}
+
+		if v, ok := d.GetOk(attRebootOption); ok {
+			ssmParameters[ssmPatchParameterRebootOption] = []string{v.(string)}
+		}
+
+		var command ssmtypes.Command
+
+		if d.Get(attWaitForCompletion).(bool) {
+			extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+			defer cancel()
+
+			var err error
+			command, err = awsClients.RunCommand(extendedCtx, &ssmDocumentRunPatchBaseline, ssmParameters, ssmTargets, &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			output, err := awsClients.ssmClient.SendCommand(ctx, &ssm.SendCommandInput{
+				Targets:            ssmTargets,
+				DocumentName:       &ssmDocumentRunPatchBaseline,
+				Parameters:         ssmParameters,
+				Comment:            &comment,
+				TimeoutSeconds:     &sendTimeout,
+				OutputS3BucketName: outputLocation.s3Bucket,
+				OutputS3KeyPrefix:  outputLocation.s3KeyPrefix,
+			})
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			command = *output.Command
+		}
+
+		d.SetId(*command.CommandId)
+
+		return setPatchCommand(ctx, awsClients, d, command)
+	}
+}
+
+func resourcePatchOperationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setPatchCommand(ctx, awsClients, d, command)
+}
+
+func resourcePatchOperationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+// Returns the schema shared by ssm_patch_scan and ssm_patch_install resources.
+func resourcePatchOperation(operation string) *schema.Resource {
+	create := resourcePatchOperationCreate(operation)
+
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: create,
+		ReadContext:   resourcePatchOperationRead,
+		UpdateContext: schema.UpdateContextFunc(create),
+		DeleteContext: resourcePatchOperationDelete,
+		Schema: map[string]*schema.Schema{
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  7200,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attPatchSummary: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attInstalledCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attInstalledOtherCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attMissingCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attFailedCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attNotApplicableCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						attOperationEndTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePatchScan() *schema.Resource {
+	return resourcePatchOperation(patchOperationScan)
+}
+
+func resourcePatchInstall() *schema.Resource {
+	resource := resourcePatchOperation(patchOperationInstall)
+
+	resource.Schema[attRebootOption] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "RebootIfNeeded",
+		ValidateFunc: validation.StringInSlice([]string{"RebootIfNeeded", "NoReboot"}, false),
+	}
+
+	return resource
+}
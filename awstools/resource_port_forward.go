@@ -0,0 +1,170 @@
+package awstools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_port_forward resource
+const (
+	attTarget     string = "target"
+	attRemoteHost string = "remote_host"
+	attRemotePort string = "remote_port"
+	attLocalPort  string = "local_port"
+	attLocalHost  string = "local_host"
+	attSessionId  string = "session_id"
+)
+
+// Port forwarding session parameters
+var ssmPortForwardParameterHost = "host"
+var ssmPortForwardParameterPortNumber = "portNumber"
+var ssmPortForwardParameterLocalPortNumber = "localPortNumber"
+
+func resourcePortForwardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	localPort := d.Get(attLocalPort).(int)
+
+	if localPort == 0 {
+		port, err := getFreeLocalPort()
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		localPort = port
+	}
+
+	sessionId, err := awsClients.StartSession(ctx, &ssm.StartSessionInput{
+		Target:       aws.String(d.Get(attTarget).(string)),
+		DocumentName: aws.String(d.Get(attDocumentName).(string)),
+		Parameters: map[string][]string{
+			ssmPortForwardParameterHost:            {d.Get(attRemoteHost).(string)},
+			ssmPortForwardParameterPortNumber:      {strconv.Itoa(d.Get(attRemotePort).(int))},
+			ssmPortForwardParameterLocalPortNumber: {strconv.Itoa(localPort)},
+		},
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(sessionId)
+
+	if err := waitForLocalPort(ctx, localPort, localPortWaitTimeout); err != nil {
+		awsClients.TerminateSession(ctx, sessionId)
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attLocalPort: localPort,
+		attLocalHost: "127.0.0.1",
+		attSessionId: sessionId,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePortForwardRead(ctx, d, m)
+}
+
+// The tunnel only lives as long as the provider which opened it, and terraform runs a new provider for each plan and apply.
+// The resource is removed from the state when the tunnel is not open, so that the next apply opens it again.
+// The session left by the previous run is terminated first, otherwise it stays open until its idle timeout.
+func resourcePortForwardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if !isSessionOpen(d.Id()) {
+		if err := awsClients.TerminateSession(ctx, d.Id()); err != nil {
+			log.Warn(ctx, fmt.Sprintf("Failed to terminate session %s of the previous run: %s", d.Id(), err))
+		}
+
+		d.SetId("")
+	}
+
+	return diags
+}
+
+func resourcePortForwardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	if err := awsClients.TerminateSession(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePortForward() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePortForwardCreate,
+		ReadContext:   resourcePortForwardRead,
+		DeleteContext: resourcePortForwardDelete,
+		Schema: map[string]*schema.Schema{
+			attTarget: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attRemoteHost: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attRemotePort: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			attLocalPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "AWS-StartPortForwardingSessionToRemoteHost",
+			},
+			attLocalHost: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attSessionId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
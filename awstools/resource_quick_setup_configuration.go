@@ -0,0 +1,306 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmquicksetup"
+	quicksetuptypes "github.com/aws/aws-sdk-go-v2/service/ssmquicksetup/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_quick_setup_configuration resource
+const (
+	attConfigurationDefinition              string = "configuration_definition"
+	attTypeVersion                          string = "type_version"
+	attLocalDeploymentAdministrationRoleArn string = "local_deployment_administration_role_arn"
+	attLocalDeploymentExecutionRoleName     string = "local_deployment_execution_role_name"
+	attStatusMessage                        string = "status_message"
+)
+
+func expandConfigurationDefinitions(d *schema.ResourceData) []quicksetuptypes.ConfigurationDefinitionInput {
+	var definitions []quicksetuptypes.ConfigurationDefinitionInput
+
+	for _, v := range d.Get(attConfigurationDefinition).([]interface{}) {
+		block := v.(map[string]interface{})
+
+		definition := quicksetuptypes.ConfigurationDefinitionInput{
+			Type:       aws.String(block[attType].(string)),
+			Parameters: expandStringMap(block[attParameters].(map[string]interface{})),
+		}
+
+		if v := block[attTypeVersion].(string); v != "" {
+			definition.TypeVersion = aws.String(v)
+		}
+
+		if v := block[attLocalDeploymentAdministrationRoleArn].(string); v != "" {
+			definition.LocalDeploymentAdministrationRoleArn = aws.String(v)
+		}
+
+		if v := block[attLocalDeploymentExecutionRoleName].(string); v != "" {
+			definition.LocalDeploymentExecutionRoleName = aws.String(v)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions
+}
+
+func flattenConfigurationDefinitions(definitions []quicksetuptypes.ConfigurationDefinition) []map[string]interface{} {
+	var blocks []map[string]interface{}
+
+	for _, definition := range definitions {
+		blocks = append(blocks, map[string]interface{}{
+			attId:                                   aws.ToString(definition.Id),
+			attType:                                 aws.ToString(definition.Type),
+			attTypeVersion:                          aws.ToString(definition.TypeVersion),
+			attParameters:                           definition.Parameters,
+			attLocalDeploymentAdministrationRoleArn: aws.ToString(definition.LocalDeploymentAdministrationRoleArn),
+			attLocalDeploymentExecutionRoleName:     aws.ToString(definition.LocalDeploymentExecutionRoleName),
+		})
+	}
+
+	return blocks
+}
+
+// Creates Quick Setup configuration manager and waits for the deployment of the configurations.
+func resourceQuickSetupConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssmquicksetup.CreateConfigurationManagerInput{
+		ConfigurationDefinitions: expandConfigurationDefinitions(d),
+		Tags:                     expandStringMap(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := awsClients.quickSetupClient.CreateConfigurationManager(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*output.ManagerArn)
+
+	if _, err := awsClients.waitForConfigurationManagerDeployed(ctx, d.Id(), quickSetupWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceQuickSetupConfigurationRead(ctx, d, m)
+}
+
+func resourceQuickSetupConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	manager, err := awsClients.getConfigurationManager(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if manager == nil {
+		d.SetId("")
+		return diags
+	}
+
+	status := getDeploymentStatus(manager)
+
+	values := map[string]interface{}{
+		attName:                    manager.Name,
+		attDescription:             manager.Description,
+		attConfigurationDefinition: flattenConfigurationDefinitions(manager.ConfigurationDefinitions),
+		attTags:                    manager.Tags,
+		attArn:                     manager.ManagerArn,
+		attStatus:                  status.Status,
+		attStatusMessage:           status.StatusMessage,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// Updates the configuration manager and the changed configuration definitions in place.
+// Adding, removing or changing the type of the configuration definitions recreates the configuration manager.
+func resourceQuickSetupConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	managerArn := d.Id()
+
+	if d.HasChanges(attName, attDescription) {
+		_, err := awsClients.quickSetupClient.UpdateConfigurationManager(ctx, &ssmquicksetup.UpdateConfigurationManagerInput{
+			ManagerArn:  &managerArn,
+			Name:        aws.String(d.Get(attName).(string)),
+			Description: aws.String(d.Get(attDescription).(string)),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attConfigurationDefinition) {
+		oldDefinitions, _ := d.GetChange(attConfigurationDefinition)
+
+		for i, definition := range expandConfigurationDefinitions(d) {
+			id := oldDefinitions.([]interface{})[i].(map[string]interface{})[attId].(string)
+
+			_, err := awsClients.quickSetupClient.UpdateConfigurationDefinition(ctx, &ssmquicksetup.UpdateConfigurationDefinitionInput{
+				ManagerArn:                           &managerArn,
+				Id:                                   &id,
+				Parameters:                           definition.Parameters,
+				TypeVersion:                          definition.TypeVersion,
+				LocalDeploymentAdministrationRoleArn: definition.LocalDeploymentAdministrationRoleArn,
+				LocalDeploymentExecutionRoleName:     definition.LocalDeploymentExecutionRoleName,
+			})
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if _, err := awsClients.waitForConfigurationManagerDeployed(ctx, managerArn, quickSetupWaitTimeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateQuickSetupTags(ctx, managerArn, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceQuickSetupConfigurationRead(ctx, d, m)
+}
+
+func resourceQuickSetupConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	managerArn := d.Id()
+
+	_, err := awsClients.quickSetupClient.DeleteConfigurationManager(ctx, &ssmquicksetup.DeleteConfigurationManagerInput{
+		ManagerArn: &managerArn,
+	})
+
+	var notFound *quicksetuptypes.ResourceNotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceQuickSetupConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceQuickSetupConfigurationCreate,
+		ReadContext:   resourceQuickSetupConfigurationRead,
+		UpdateContext: resourceQuickSetupConfigurationUpdate,
+		DeleteContext: resourceQuickSetupConfigurationDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attConfigurationDefinition: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attType: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						attTypeVersion: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						attParameters: {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attLocalDeploymentAdministrationRoleArn: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attLocalDeploymentExecutionRoleName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
@@ -0,0 +1,245 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_remote_state resource
+const (
+	attCheck          string = "check"
+	attApply          string = "apply"
+	attCheckCommandId string = "check_command_id"
+	attApplyCommandId string = "apply_command_id"
+)
+
+// The check command runs on refresh, so it may take longer than the other resources reads.
+var checkTimeout time.Duration = time.Duration(1) * time.Hour
+
+// Runs the check command.
+// Returns false if the check command fails on any target instance.
+func runCheckCommand(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData) (ssmtypes.Command, bool, error) {
+	documentName := d.Get(attDocumentName).(string)
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {d.Get(attCheck).(string)},
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	var invocationErr *CommandInvocationError
+	if errors.As(err, &invocationErr) && invocationErr.Status == ssmtypes.CommandInvocationStatusFailed {
+		log.Info(ctx, fmt.Sprintf("Check failed on instance %s.", invocationErr.InstanceId))
+		return command, false, nil
+	}
+
+	if err != nil {
+		return command, false, err
+	}
+
+	return command, true, nil
+}
+
+// Runs the apply command only if the check command fails.
+// Verifies with the check command that the apply command converged the remote state.
+func resourceRemoteStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, inSync, err := runCheckCommand(ctx, awsClients, d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var applyCommandId string
+
+	if !inSync {
+		documentName := d.Get(attDocumentName).(string)
+		executionTimeout := d.Get(attExecutionTimeout).(int)
+		comment := d.Get(attComment).(string)
+		outputLocation := getOutputLocation(d)
+
+		ssmParameters := map[string][]string{
+			ssmParameterCommands: {d.Get(attApply).(string)},
+		}
+
+		extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+		defer cancel()
+
+		applyCommand, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		applyCommandId = *applyCommand.CommandId
+
+		command, inSync, err = runCheckCommand(ctx, awsClients, d)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !inSync {
+			return diag.Errorf("check failed after apply command %s", applyCommandId)
+		}
+	}
+
+	d.SetId(*command.CommandId)
+
+	values := map[string]interface{}{
+		attCheckCommandId: command.CommandId,
+		attApplyCommandId: applyCommandId,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Runs the check command on the target instances.
+// The resource is removed from the state if the check fails, so that the next apply converges the remote state.
+func resourceRemoteStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, inSync, err := runCheckCommand(ctx, awsClients, d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !inSync {
+		log.Info(ctx, "Remote state drifted.")
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(attCheckCommandId, command.CommandId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRemoteStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceRemoteStateCreate(ctx, d, m)
+}
+
+func resourceRemoteStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceRemoteState() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &checkTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceRemoteStateCreate,
+		ReadContext:   resourceRemoteStateRead,
+		UpdateContext: resourceRemoteStateUpdate,
+		DeleteContext: resourceRemoteStateDelete,
+		Schema: map[string]*schema.Schema{
+			attCheck: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attApply: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ssmDocumentRunShellScript,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attCheckCommandId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attApplyCommandId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
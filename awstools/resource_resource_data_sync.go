@@ -0,0 +1,242 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_resource_data_sync resource
+const (
+	attS3Destination         string = "s3_destination"
+	attSyncFormat            string = "sync_format"
+	attKmsKeyArn             string = "kms_key_arn"
+	attLastStatus            string = "last_status"
+	attLastSyncTime          string = "last_sync_time"
+	attLastSyncStatusMessage string = "last_sync_status_message"
+)
+
+// Retrieves SSM resource data sync to S3 by name.
+// Resource data syncs to S3 are listed when no sync type is requested.
+func (clients AwsClients) getResourceDataSync(ctx context.Context, syncName string) (ssmtypes.ResourceDataSyncItem, error) {
+	input := &ssm.ListResourceDataSyncInput{}
+
+	for {
+		output, err := clients.ssmClient.ListResourceDataSync(ctx, input)
+
+		if err != nil {
+			return ssmtypes.ResourceDataSyncItem{}, err
+		}
+
+		for _, item := range output.ResourceDataSyncItems {
+			if aws.ToString(item.SyncName) == syncName {
+				return item, nil
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return ssmtypes.ResourceDataSyncItem{}, nil
+}
+
+func getS3Destination(d *schema.ResourceData) *ssmtypes.ResourceDataSyncS3Destination {
+	prefix := attS3Destination + ".0."
+
+	destination := &ssmtypes.ResourceDataSyncS3Destination{
+		BucketName: aws.String(d.Get(prefix + attS3BucketName).(string)),
+		Region:     aws.String(d.Get(prefix + attS3Region).(string)),
+		SyncFormat: ssmtypes.ResourceDataSyncS3Format(d.Get(prefix + attSyncFormat).(string)),
+	}
+
+	if v, ok := d.GetOk(prefix + attS3KeyPrefix); ok {
+		destination.Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(prefix + attKmsKeyArn); ok {
+		destination.AWSKMSKeyARN = aws.String(v.(string))
+	}
+
+	return destination
+}
+
+func flattenS3Destination(destination *ssmtypes.ResourceDataSyncS3Destination) []interface{} {
+	if destination == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			attS3BucketName: aws.ToString(destination.BucketName),
+			attS3KeyPrefix:  aws.ToString(destination.Prefix),
+			attS3Region:     aws.ToString(destination.Region),
+			attSyncFormat:   string(destination.SyncFormat),
+			attKmsKeyArn:    aws.ToString(destination.AWSKMSKeyARN),
+		},
+	}
+}
+
+func resourceResourceDataSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	syncName := d.Get(attName).(string)
+
+	_, err := awsClients.ssmClient.CreateResourceDataSync(ctx, &ssm.CreateResourceDataSyncInput{
+		SyncName:      &syncName,
+		S3Destination: getS3Destination(d),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(syncName)
+
+	return resourceResourceDataSyncRead(ctx, d, m)
+}
+
+func resourceResourceDataSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	item, err := awsClients.getResourceDataSync(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if item.SyncName == nil {
+		d.SetId("")
+		return diags
+	}
+
+	var lastSyncTime string
+	if item.LastSyncTime != nil {
+		lastSyncTime = item.LastSyncTime.UTC().Format(time.RFC3339)
+	}
+
+	values := map[string]interface{}{
+		attName:                  item.SyncName,
+		attS3Destination:         flattenS3Destination(item.S3Destination),
+		attLastStatus:            item.LastStatus,
+		attLastSyncTime:          lastSyncTime,
+		attLastSyncStatusMessage: item.LastSyncStatusMessage,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceResourceDataSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	syncName := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteResourceDataSync(ctx, &ssm.DeleteResourceDataSyncInput{
+		SyncName: &syncName,
+	})
+
+	var notFound *ssmtypes.ResourceDataSyncNotFoundException
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceResourceDataSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResourceDataSyncCreate,
+		ReadContext:   resourceResourceDataSyncRead,
+		DeleteContext: resourceResourceDataSyncDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attS3Destination: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						attS3Region: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						attSyncFormat: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      string(ssmtypes.ResourceDataSyncS3FormatJsonSerde),
+							ValidateFunc: validation.StringInSlice(enumValues(ssmtypes.ResourceDataSyncS3Format("").Values()), false),
+						},
+						attKmsKeyArn: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			attLastStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastSyncTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attLastSyncStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
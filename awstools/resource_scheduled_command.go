@@ -0,0 +1,435 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Maintenance window task target key referencing the window targets
+var ssmTargetWindowTargetIds = "WindowTargetIds"
+
+func getScheduledCommandInvocationParameters(d *schema.ResourceData) *ssmtypes.MaintenanceWindowTaskInvocationParameters {
+	outputLocation := getOutputLocation(d)
+
+	runCommand := &ssmtypes.MaintenanceWindowRunCommandParameters{
+		Parameters:         getParameters(d, attParameters),
+		TimeoutSeconds:     aws.Int32(int32(d.Get(attExecutionTimeout).(int))),
+		OutputS3BucketName: outputLocation.s3Bucket,
+		OutputS3KeyPrefix:  outputLocation.s3KeyPrefix,
+	}
+
+	if v, ok := d.GetOk(attComment); ok {
+		runCommand.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attDocumentVersion); ok {
+		runCommand.DocumentVersion = aws.String(v.(string))
+	}
+
+	return &ssmtypes.MaintenanceWindowTaskInvocationParameters{RunCommand: runCommand}
+}
+
+// Creates the maintenance window, registers the targets and the run command task with the window.
+// The window Id is stored first so that the window is deleted with the registered targets if a later step fails.
+func resourceScheduledCommandCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	input := &ssm.CreateMaintenanceWindowInput{
+		Name:                     aws.String(d.Get(attName).(string)),
+		Schedule:                 aws.String(d.Get(attSchedule).(string)),
+		Duration:                 aws.Int32(int32(d.Get(attDuration).(int))),
+		Cutoff:                   int32(d.Get(attCutoff).(int)),
+		AllowUnassociatedTargets: false,
+		Tags:                     expandTags(d.Get(attTags).(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk(attScheduleTimezone); ok {
+		input.ScheduleTimezone = aws.String(v.(string))
+	}
+
+	window, err := awsClients.ssmClient.CreateMaintenanceWindow(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	windowId := *window.WindowId
+
+	d.SetId(windowId)
+
+	if !d.Get(attEnabled).(bool) {
+		_, err := awsClients.ssmClient.UpdateMaintenanceWindow(ctx, &ssm.UpdateMaintenanceWindowInput{
+			WindowId: &windowId,
+			Enabled:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	target, err := awsClients.ssmClient.RegisterTargetWithMaintenanceWindow(ctx, &ssm.RegisterTargetWithMaintenanceWindowInput{
+		WindowId:     &windowId,
+		ResourceType: ssmtypes.MaintenanceWindowResourceTypeInstance,
+		Targets:      getTargets(d),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	taskInput := &ssm.RegisterTaskWithMaintenanceWindowInput{
+		WindowId:                 &windowId,
+		TaskType:                 ssmtypes.MaintenanceWindowTaskTypeRunCommand,
+		TaskArn:                  aws.String(d.Get(attDocumentName).(string)),
+		Targets:                  []ssmtypes.Target{{Key: &ssmTargetWindowTargetIds, Values: []string{*target.WindowTargetId}}},
+		TaskInvocationParameters: getScheduledCommandInvocationParameters(d),
+	}
+
+	if v, ok := d.GetOk(attMaxConcurrency); ok {
+		taskInput.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attMaxErrors); ok {
+		taskInput.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(attServiceRoleArn); ok {
+		taskInput.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if _, err := awsClients.ssmClient.RegisterTaskWithMaintenanceWindow(ctx, taskInput); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduledCommandRead(ctx, d, m)
+}
+
+func resourceScheduledCommandRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Id()
+
+	window, err := awsClients.ssmClient.GetMaintenanceWindow(ctx, &ssm.GetMaintenanceWindowInput{
+		WindowId: &windowId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := awsClients.listTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attName:             window.Name,
+		attSchedule:         window.Schedule,
+		attScheduleTimezone: window.ScheduleTimezone,
+		attDuration:         window.Duration,
+		attCutoff:           window.Cutoff,
+		attEnabled:          window.Enabled,
+		attTags:             tags,
+		attWindowId:         window.WindowId,
+	}
+
+	targets, err := awsClients.ssmClient.DescribeMaintenanceWindowTargets(ctx, &ssm.DescribeMaintenanceWindowTargetsInput{
+		WindowId: &windowId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(targets.Targets) > 0 {
+		values[attWindowTargetId] = targets.Targets[0].WindowTargetId
+		values[attTargets] = flattenTargets(targets.Targets[0].Targets)
+	}
+
+	tasks, err := awsClients.ssmClient.DescribeMaintenanceWindowTasks(ctx, &ssm.DescribeMaintenanceWindowTasksInput{
+		WindowId: &windowId,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(tasks.Tasks) > 0 {
+		task, err := awsClients.ssmClient.GetMaintenanceWindowTask(ctx, &ssm.GetMaintenanceWindowTaskInput{
+			WindowId:     &windowId,
+			WindowTaskId: tasks.Tasks[0].WindowTaskId,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		values[attWindowTaskId] = task.WindowTaskId
+		values[attDocumentName] = task.TaskArn
+		values[attMaxConcurrency] = task.MaxConcurrency
+		values[attMaxErrors] = task.MaxErrors
+		values[attServiceRoleArn] = task.ServiceRoleArn
+
+		if parameters := task.TaskInvocationParameters; parameters != nil && parameters.RunCommand != nil {
+			values[attDocumentVersion] = parameters.RunCommand.DocumentVersion
+			values[attParameters] = flattenParameters(d, attParameters, parameters.RunCommand.Parameters)
+		}
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceScheduledCommandUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	windowId := d.Id()
+
+	if d.HasChanges(attName, attSchedule, attScheduleTimezone, attDuration, attCutoff, attEnabled) {
+		input := &ssm.UpdateMaintenanceWindowInput{
+			WindowId:                 &windowId,
+			Name:                     aws.String(d.Get(attName).(string)),
+			Schedule:                 aws.String(d.Get(attSchedule).(string)),
+			Duration:                 aws.Int32(int32(d.Get(attDuration).(int))),
+			Cutoff:                   aws.Int32(int32(d.Get(attCutoff).(int))),
+			AllowUnassociatedTargets: aws.Bool(false),
+			Enabled:                  aws.Bool(d.Get(attEnabled).(bool)),
+			Replace:                  aws.Bool(true),
+		}
+
+		if v, ok := d.GetOk(attScheduleTimezone); ok {
+			input.ScheduleTimezone = aws.String(v.(string))
+		}
+
+		if _, err := awsClients.ssmClient.UpdateMaintenanceWindow(ctx, input); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTargets) {
+		_, err := awsClients.ssmClient.UpdateMaintenanceWindowTarget(ctx, &ssm.UpdateMaintenanceWindowTargetInput{
+			WindowId:       &windowId,
+			WindowTargetId: aws.String(d.Get(attWindowTargetId).(string)),
+			Targets:        getTargets(d),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges(attDocumentName, attDocumentVersion, attParameters, attExecutionTimeout, attComment, attOutputLocation, attMaxConcurrency, attMaxErrors, attServiceRoleArn) {
+		input := &ssm.UpdateMaintenanceWindowTaskInput{
+			WindowId:                 &windowId,
+			WindowTaskId:             aws.String(d.Get(attWindowTaskId).(string)),
+			TaskArn:                  aws.String(d.Get(attDocumentName).(string)),
+			Targets:                  []ssmtypes.Target{{Key: &ssmTargetWindowTargetIds, Values: []string{d.Get(attWindowTargetId).(string)}}},
+			TaskInvocationParameters: getScheduledCommandInvocationParameters(d),
+			Replace:                  aws.Bool(true),
+		}
+
+		if v, ok := d.GetOk(attMaxConcurrency); ok {
+			input.MaxConcurrency = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attMaxErrors); ok {
+			input.MaxErrors = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk(attServiceRoleArn); ok {
+			input.ServiceRoleArn = aws.String(v.(string))
+		}
+
+		if _, err := awsClients.ssmClient.UpdateMaintenanceWindowTask(ctx, input); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(attTags) {
+		oldTags, newTags := d.GetChange(attTags)
+
+		err := awsClients.updateTags(ctx, ssmtypes.ResourceTypeForTaggingMaintenanceWindow, windowId, oldTags.(map[string]interface{}), newTags.(map[string]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScheduledCommandRead(ctx, d, m)
+}
+
+func resourceScheduledCommand() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduledCommandCreate,
+		ReadContext:   resourceScheduledCommandRead,
+		UpdateContext: resourceScheduledCommandUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		Schema: map[string]*schema.Schema{
+			attName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attSchedule: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attScheduleTimezone: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attDuration: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(1, 24),
+			},
+			attCutoff: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			attEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attDocumentName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attDocumentVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attMaxConcurrency: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attMaxErrors: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attServiceRoleArn: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attTags: tagsSchema(),
+			attWindowId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attWindowTargetId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attWindowTaskId: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
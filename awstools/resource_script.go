@@ -0,0 +1,270 @@
+package awstools
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_script resource
+const (
+	attInterpreter string = "interpreter"
+)
+
+// Script interpreters
+const (
+	interpreterShell      string = "shell"
+	interpreterPowerShell string = "powershell"
+)
+
+var ssmDocumentRunPowerShellScript = "AWS-RunPowerShellScript"
+
+// Scripts larger than this are staged to S3, keeping the command parameters within SSM size limit.
+const maxInlineScriptSize = 32768
+
+func getScriptDocumentName(interpreter string) string {
+	if interpreter == interpreterPowerShell {
+		return ssmDocumentRunPowerShellScript
+	}
+
+	return ssmDocumentRunShellScript
+}
+
+// Builds the command downloading the staged script, verifying its checksum and running it.
+func getStagedScriptCommand(interpreter string, contentUrl string, content []byte) string {
+	hash := getContentSha256(content)
+
+	if interpreter == interpreterPowerShell {
+		return strings.Join([]string{
+			"$ErrorActionPreference = 'Stop'",
+			"$tmp = Join-Path ([IO.Path]::GetTempPath()) ([IO.Path]::GetRandomFileName() + '.ps1')",
+			"try {",
+			"  Invoke-WebRequest -UseBasicParsing -Uri " + powershellQuote(contentUrl) + " -OutFile $tmp",
+			"  if ((Get-FileHash -Algorithm SHA256 $tmp).Hash -ne '" + hash + "') { throw 'Script checksum mismatch' }",
+			"  & $tmp",
+			"  exit $LASTEXITCODE",
+			"} finally {",
+			"  Remove-Item -Force -ErrorAction SilentlyContinue $tmp",
+			"}",
+		}, "\n")
+	}
+
+	return strings.Join([]string{
+		"set -e",
+		"tmp=$(mktemp)",
+		`trap 'rm -f "$tmp"' EXIT`,
+		"curl -fsSL " + shellQuote(contentUrl) + ` -o "$tmp"`,
+		`echo "` + hash + `  $tmp" | sha256sum -c --quiet -`,
+		`chmod +x "$tmp"`,
+		`"$tmp"`,
+	}, "\n")
+}
+
+func resourceScriptCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	interpreter := d.Get(attInterpreter).(string)
+	documentName := getScriptDocumentName(interpreter)
+	outputLocation := getOutputLocation(d)
+
+	content, err := getFileContent(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	script := string(content)
+
+	if len(content) > maxInlineScriptSize {
+		s3Bucket, ok := d.GetOk(attS3BucketName)
+		if !ok {
+			return diag.Errorf("script size %d exceeds %d bytes, %s is required to stage it", len(content), maxInlineScriptSize, attS3BucketName)
+		}
+
+		key := getContentSha256(content)
+		if prefix := d.Get(attS3KeyPrefix).(string); prefix != "" {
+			key = prefix + "/" + key
+		}
+
+		// The URL must stay valid while the command waits for the target instances.
+		expires := time.Duration(executionTimeout+waitTimeout) * time.Second
+
+		contentUrl, err := awsClients.stageObject(extendedCtx, s3Bucket.(string), key, content, expires)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The script may embed secrets, it is deleted once the command completes.
+		defer awsClients.deleteObject(ctx, s3Bucket.(string), key)
+
+		script = getStagedScriptCommand(interpreter, contentUrl, content)
+	}
+
+	ssmParameters := map[string][]string{
+		ssmParameterCommands: {script},
+	}
+
+	command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	if err := d.Set(attContentSha256, getContentSha256(content)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceScriptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setFileCommand(d, string(command.Status), command.RequestedDateTime.UTC().Format(time.RFC3339))
+}
+
+func resourceScriptUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceScriptCreate(ctx, d, m)
+}
+
+func resourceScriptDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceScript() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceScriptCreate,
+		ReadContext:   resourceScriptRead,
+		UpdateContext: resourceScriptUpdate,
+		DeleteContext: resourceScriptDelete,
+		CustomizeDiff: resourceFileCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			attContent: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{attContent, attSource},
+			},
+			attSource: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attInterpreter: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      interpreterShell,
+				ValidateFunc: validation.StringInSlice([]string{interpreterShell, interpreterPowerShell}, false),
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attContentSha256: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
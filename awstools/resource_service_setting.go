@@ -0,0 +1,140 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes of ssm_service_setting resource
+const (
+	attSettingId    string = "setting_id"
+	attSettingValue string = "setting_value"
+)
+
+func resourceServiceSettingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	settingId := d.Get(attSettingId).(string)
+
+	_, err := awsClients.ssmClient.UpdateServiceSetting(ctx, &ssm.UpdateServiceSettingInput{
+		SettingId:    &settingId,
+		SettingValue: aws.String(d.Get(attSettingValue).(string)),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(settingId)
+
+	return resourceServiceSettingRead(ctx, d, m)
+}
+
+func resourceServiceSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	settingId := d.Id()
+
+	output, err := awsClients.ssmClient.GetServiceSetting(ctx, &ssm.GetServiceSettingInput{
+		SettingId: &settingId,
+	})
+
+	var notFound *ssmtypes.ServiceSettingNotFound
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		attSettingId:    settingId,
+		attSettingValue: output.ServiceSetting.SettingValue,
+		attStatus:       output.ServiceSetting.Status,
+		attArn:          output.ServiceSetting.ARN,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceServiceSettingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceServiceSettingCreate(ctx, d, m)
+}
+
+// Resets the service setting to its default value.
+func resourceServiceSettingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	settingId := d.Id()
+
+	_, err := awsClients.ssmClient.ResetServiceSetting(ctx, &ssm.ResetServiceSettingInput{
+		SettingId: &settingId,
+	})
+
+	var notFound *ssmtypes.ServiceSettingNotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceServiceSetting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceSettingCreate,
+		ReadContext:   resourceServiceSettingRead,
+		UpdateContext: resourceServiceSettingUpdate,
+		DeleteContext: resourceServiceSettingDelete,
+		Schema: map[string]*schema.Schema{
+			attSettingId: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attSettingValue: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attArn: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
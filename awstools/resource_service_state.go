@@ -0,0 +1,325 @@
+package awstools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_service_state resource
+const (
+	attServiceName    string = "service_name"
+	attState          string = "state"
+	attInstanceStates string = "instance_states"
+)
+
+// Service states
+const (
+	serviceStateRunning string = "running"
+	serviceStateStopped string = "stopped"
+)
+
+// Lines of the status command output, e.g. "active=active" and "enabled=disabled"
+var serviceActiveRegexp = regexache.MustCompile(`(?m)^active=(\S*)`)
+var serviceEnabledRegexp = regexache.MustCompile(`(?m)^enabled=(\S*)`)
+
+// Builds the scripts reporting the service state by interpreter.
+func getServiceStatusScripts(name string) map[string]string {
+	return map[string]string{
+		interpreterShell: strings.Join([]string{
+			"name=" + shellQuote(name),
+			`echo "active=$(systemctl is-active "$name")"`,
+			`echo "enabled=$(systemctl is-enabled "$name")"`,
+		}, "\n"),
+		interpreterPowerShell: strings.Join([]string{
+			"$service = Get-Service -Name " + powershellQuote(name) + " -ErrorAction Stop",
+			"Write-Output ('active=' + $(if ($service.Status -eq 'Running') { 'active' } else { 'inactive' }))",
+			"Write-Output ('enabled=' + $(if ($service.StartType -eq 'Automatic') { 'enabled' } else { 'disabled' }))",
+		}, "\n"),
+	}
+}
+
+// Builds the scripts converging the service to the desired state by interpreter.
+func getServiceApplyScripts(name string, state string, enabled bool) map[string]string {
+	systemctlEnable, startupType := "disable", "Manual"
+	if enabled {
+		systemctlEnable, startupType = "enable", "Automatic"
+	}
+
+	systemctlStart, serviceCmdlet := "stop", "Stop-Service"
+	if state == serviceStateRunning {
+		systemctlStart, serviceCmdlet = "start", "Start-Service"
+	}
+
+	return map[string]string{
+		interpreterShell: strings.Join([]string{
+			"set -e",
+			"systemctl " + systemctlEnable + " " + shellQuote(name),
+			"systemctl " + systemctlStart + " " + shellQuote(name),
+		}, "\n"),
+		interpreterPowerShell: strings.Join([]string{
+			"$ErrorActionPreference = 'Stop'",
+			"Set-Service -Name " + powershellQuote(name) + " -StartupType " + startupType,
+			serviceCmdlet + " -Name " + powershellQuote(name),
+		}, "\n"),
+	}
+}
+
+// Runs the script matching the platform of each target instance.
+// Returns the Id of the last command and the outputs of the command invocations by instance Id.
+func runPlatformScripts(ctx context.Context, awsClients *AwsClients, d *schema.ResourceData, scripts map[string]string) (string, map[string]string, error) {
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	instances, err := awsClients.listTargetInstances(ctx, getTargets(d))
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(instances) == 0 {
+		return "", nil, errors.New("no managed instances match the targets")
+	}
+
+	instanceIds := make(map[string][]string)
+	for _, instance := range instances {
+		interpreter := interpreterShell
+		if instance.PlatformType == ssmtypes.PlatformTypeWindows {
+			interpreter = interpreterPowerShell
+		}
+		instanceIds[interpreter] = append(instanceIds[interpreter], aws.ToString(instance.InstanceId))
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	var commandId string
+	outputs := make(map[string]string)
+
+	for _, interpreter := range sortedKeys(instanceIds) {
+		documentName := getScriptDocumentName(interpreter)
+		ids := instanceIds[interpreter]
+
+		ssmParameters := map[string][]string{
+			ssmParameterCommands: {scripts[interpreter]},
+		}
+
+		for start := 0; start < len(ids); start += instanceInformationBatchSize {
+			end := min(start+instanceInformationBatchSize, len(ids))
+
+			ssmTargets := []ssmtypes.Target{{Key: &ssmTargetInstanceIds, Values: ids[start:end]}}
+
+			command, err := awsClients.RunCommand(extendedCtx, &documentName, ssmParameters, ssmTargets, &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+			if err != nil {
+				return "", nil, err
+			}
+
+			commandId = *command.CommandId
+
+			commandOutputs, err := awsClients.listCommandOutputs(extendedCtx, commandId)
+
+			if err != nil {
+				return "", nil, err
+			}
+
+			for instanceId, output := range commandOutputs {
+				outputs[instanceId] = output
+			}
+		}
+	}
+
+	return commandId, outputs, nil
+}
+
+// Runs the status command on the target instances.
+// The state attributes are set to the observed state of the drifted instances, so that the drift shows up in the plan.
+func resourceServiceStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	_, outputs, err := runPlatformScripts(ctx, awsClients, d, getServiceStatusScripts(d.Get(attServiceName).(string)))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desiredState := d.Get(attState).(string)
+	desiredEnabled := d.Get(attEnabled).(bool)
+
+	state, enabled := desiredState, desiredEnabled
+	instanceStates := make(map[string]string)
+
+	for _, instanceId := range sortedKeys(outputs) {
+		instanceState, instanceEnabled := serviceStateStopped, "disabled"
+
+		if match := serviceActiveRegexp.FindStringSubmatch(outputs[instanceId]); match != nil && match[1] == "active" {
+			instanceState = serviceStateRunning
+		}
+
+		if match := serviceEnabledRegexp.FindStringSubmatch(outputs[instanceId]); match != nil && match[1] == "enabled" {
+			instanceEnabled = "enabled"
+		}
+
+		instanceStates[instanceId] = instanceState + "/" + instanceEnabled
+
+		if instanceState != desiredState {
+			log.Info(ctx, fmt.Sprintf("Service is %s on instance %s.", instanceState, instanceId))
+			state = instanceState
+		}
+
+		if (instanceEnabled == "enabled") != desiredEnabled {
+			log.Info(ctx, fmt.Sprintf("Service is %s on instance %s.", instanceEnabled, instanceId))
+			enabled = !desiredEnabled
+		}
+	}
+
+	values := map[string]interface{}{
+		attState:          state,
+		attEnabled:        enabled,
+		attInstanceStates: instanceStates,
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// Runs the command converging the service to the desired state on the target instances.
+func resourceServiceStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	scripts := getServiceApplyScripts(d.Get(attServiceName).(string), d.Get(attState).(string), d.Get(attEnabled).(bool))
+
+	commandId, _, err := runPlatformScripts(ctx, awsClients, d, scripts)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(commandId)
+
+	return resourceServiceStateRead(ctx, d, m)
+}
+
+func resourceServiceStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceServiceStateCreate(ctx, d, m)
+}
+
+func resourceServiceStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceServiceState() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &checkTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceServiceStateCreate,
+		ReadContext:   resourceServiceStateRead,
+		UpdateContext: resourceServiceStateUpdate,
+		DeleteContext: resourceServiceStateDelete,
+		Schema: map[string]*schema.Schema{
+			attServiceName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			attState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      serviceStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{serviceStateRunning, serviceStateStopped}, false),
+			},
+			attEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attInstanceStates: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,358 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_session_preferences resource
+const (
+	attS3EncryptionEnabled         string = "s3_encryption_enabled"
+	attCloudWatchLogGroupName      string = "cloudwatch_log_group_name"
+	attCloudWatchEncryptionEnabled string = "cloudwatch_encryption_enabled"
+	attCloudWatchStreamingEnabled  string = "cloudwatch_streaming_enabled"
+	attRunAsEnabled                string = "run_as_enabled"
+	attRunAsDefaultUser            string = "run_as_default_user"
+	attIdleSessionTimeout          string = "idle_session_timeout"
+	attMaxSessionDuration          string = "max_session_duration"
+	attShellProfile                string = "shell_profile"
+	attLinux                       string = "linux"
+	attWindows                     string = "windows"
+)
+
+// Session Manager preferences are stored in the session document of the region.
+var ssmSessionPreferencesDocumentName = "SSM-SessionManagerRunShell"
+
+type SessionPreferences struct {
+	SchemaVersion string                   `json:"schemaVersion"`
+	Description   string                   `json:"description"`
+	SessionType   string                   `json:"sessionType"`
+	Inputs        SessionPreferencesInputs `json:"inputs"`
+}
+
+type SessionPreferencesInputs struct {
+	S3BucketName                string       `json:"s3BucketName"`
+	S3KeyPrefix                 string       `json:"s3KeyPrefix"`
+	S3EncryptionEnabled         bool         `json:"s3EncryptionEnabled"`
+	CloudWatchLogGroupName      string       `json:"cloudWatchLogGroupName"`
+	CloudWatchEncryptionEnabled bool         `json:"cloudWatchEncryptionEnabled"`
+	CloudWatchStreamingEnabled  bool         `json:"cloudWatchStreamingEnabled"`
+	KmsKeyId                    string       `json:"kmsKeyId"`
+	RunAsEnabled                bool         `json:"runAsEnabled"`
+	RunAsDefaultUser            string       `json:"runAsDefaultUser"`
+	IdleSessionTimeout          string       `json:"idleSessionTimeout"`
+	MaxSessionDuration          string       `json:"maxSessionDuration"`
+	ShellProfile                ShellProfile `json:"shellProfile"`
+}
+
+type ShellProfile struct {
+	Windows string `json:"windows"`
+	Linux   string `json:"linux"`
+}
+
+func getSessionPreferencesContent(d *schema.ResourceData) (string, error) {
+	inputs := SessionPreferencesInputs{
+		S3BucketName:                d.Get(attS3BucketName).(string),
+		S3KeyPrefix:                 d.Get(attS3KeyPrefix).(string),
+		S3EncryptionEnabled:         d.Get(attS3EncryptionEnabled).(bool),
+		CloudWatchLogGroupName:      d.Get(attCloudWatchLogGroupName).(string),
+		CloudWatchEncryptionEnabled: d.Get(attCloudWatchEncryptionEnabled).(bool),
+		CloudWatchStreamingEnabled:  d.Get(attCloudWatchStreamingEnabled).(bool),
+		KmsKeyId:                    d.Get(attKmsKeyId).(string),
+		RunAsEnabled:                d.Get(attRunAsEnabled).(bool),
+		RunAsDefaultUser:            d.Get(attRunAsDefaultUser).(string),
+		IdleSessionTimeout:          strconv.Itoa(d.Get(attIdleSessionTimeout).(int)),
+		ShellProfile: ShellProfile{
+			Linux:   d.Get(attShellProfile + ".0." + attLinux).(string),
+			Windows: d.Get(attShellProfile + ".0." + attWindows).(string),
+		},
+	}
+
+	if v, ok := d.GetOk(attMaxSessionDuration); ok {
+		inputs.MaxSessionDuration = strconv.Itoa(v.(int))
+	}
+
+	bytes, err := json.Marshal(SessionPreferences{
+		SchemaVersion: "1.0",
+		Description:   "Document to hold regional settings for Session Manager",
+		SessionType:   "Standard_Stream",
+		Inputs:        inputs,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+func flattenSessionPreferences(content string) (map[string]interface{}, error) {
+	var preferences SessionPreferences
+
+	if err := json.Unmarshal([]byte(content), &preferences); err != nil {
+		return nil, err
+	}
+
+	inputs := preferences.Inputs
+
+	values := map[string]interface{}{
+		attS3BucketName:                inputs.S3BucketName,
+		attS3KeyPrefix:                 inputs.S3KeyPrefix,
+		attS3EncryptionEnabled:         inputs.S3EncryptionEnabled,
+		attCloudWatchLogGroupName:      inputs.CloudWatchLogGroupName,
+		attCloudWatchEncryptionEnabled: inputs.CloudWatchEncryptionEnabled,
+		attCloudWatchStreamingEnabled:  inputs.CloudWatchStreamingEnabled,
+		attKmsKeyId:                    inputs.KmsKeyId,
+		attRunAsEnabled:                inputs.RunAsEnabled,
+		attRunAsDefaultUser:            inputs.RunAsDefaultUser,
+		attIdleSessionTimeout:          nil,
+		attMaxSessionDuration:          nil,
+		attShellProfile:                nil,
+	}
+
+	if timeout, err := strconv.Atoi(inputs.IdleSessionTimeout); err == nil {
+		values[attIdleSessionTimeout] = timeout
+	}
+
+	if duration, err := strconv.Atoi(inputs.MaxSessionDuration); err == nil {
+		values[attMaxSessionDuration] = duration
+	}
+
+	if inputs.ShellProfile.Linux != "" || inputs.ShellProfile.Windows != "" {
+		values[attShellProfile] = []interface{}{
+			map[string]interface{}{
+				attLinux:   inputs.ShellProfile.Linux,
+				attWindows: inputs.ShellProfile.Windows,
+			},
+		}
+	}
+
+	return values, nil
+}
+
+// Creates the session document or updates it if the document was already created by AWS console.
+func resourceSessionPreferencesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	content, err := getSessionPreferencesContent(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	document, err := awsClients.GetDocument(ctx, ssmSessionPreferencesDocumentName)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if document.Name == nil {
+		_, err := awsClients.ssmClient.CreateDocument(ctx, &ssm.CreateDocumentInput{
+			Name:           &ssmSessionPreferencesDocumentName,
+			Content:        &content,
+			DocumentType:   ssmtypes.DocumentTypeSession,
+			DocumentFormat: ssmtypes.DocumentFormatJson,
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		output, err := awsClients.ssmClient.UpdateDocument(ctx, &ssm.UpdateDocumentInput{
+			Name:            &ssmSessionPreferencesDocumentName,
+			Content:         &content,
+			DocumentFormat:  ssmtypes.DocumentFormatJson,
+			DocumentVersion: aws.String("$LATEST"),
+		})
+
+		var duplicate *ssmtypes.DuplicateDocumentContent
+		if err != nil && !errors.As(err, &duplicate) {
+			return diag.FromErr(err)
+		}
+
+		if err == nil {
+			if _, err := awsClients.waitForDocumentActive(ctx, ssmSessionPreferencesDocumentName, documentWaitTimeout); err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = awsClients.ssmClient.UpdateDocumentDefaultVersion(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+				Name:            &ssmSessionPreferencesDocumentName,
+				DocumentVersion: output.DocumentDescription.DocumentVersion,
+			})
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId(ssmSessionPreferencesDocumentName)
+
+	if _, err := awsClients.waitForDocumentActive(ctx, ssmSessionPreferencesDocumentName, documentWaitTimeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSessionPreferencesRead(ctx, d, m)
+}
+
+func resourceSessionPreferencesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	output, err := awsClients.ssmClient.GetDocument(ctx, &ssm.GetDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if errors.As(err, &notFound) {
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values, err := flattenSessionPreferences(aws.ToString(output.Content))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceSessionPreferencesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceSessionPreferencesCreate(ctx, d, m)
+}
+
+// Deletes the session document restoring the default Session Manager preferences.
+func resourceSessionPreferencesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	name := d.Id()
+
+	_, err := awsClients.ssmClient.DeleteDocument(ctx, &ssm.DeleteDocumentInput{
+		Name: &name,
+	})
+
+	var notFound *ssmtypes.InvalidDocument
+	if err != nil && !errors.As(err, &notFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSessionPreferences() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSessionPreferencesCreate,
+		ReadContext:   resourceSessionPreferencesRead,
+		UpdateContext: resourceSessionPreferencesUpdate,
+		DeleteContext: resourceSessionPreferencesDelete,
+		Schema: map[string]*schema.Schema{
+			attS3BucketName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attS3KeyPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attS3EncryptionEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attCloudWatchLogGroupName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attCloudWatchEncryptionEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attCloudWatchStreamingEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			attKmsKeyId: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attRunAsEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attRunAsDefaultUser: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			attIdleSessionTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			attMaxSessionDuration: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+			attShellProfile: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attLinux: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attWindows: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
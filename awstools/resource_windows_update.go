@@ -0,0 +1,336 @@
+package awstools
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes of ssm_windows_update resource
+const (
+	attSeverityLevels   string = "severity_levels"
+	attIncludeKbs       string = "include_kbs"
+	attExcludeKbs       string = "exclude_kbs"
+	attAllowReboot      string = "allow_reboot"
+	attPublishedDaysOld string = "published_days_old"
+	attUpdateResults    string = "update_results"
+	attInstalledKbs     string = "installed_kbs"
+	attFailedKbs        string = "failed_kbs"
+)
+
+var ssmDocumentInstallWindowsUpdates = "AWS-InstallWindowsUpdates"
+
+// AWS-InstallWindowsUpdates parameters
+var windowsUpdateParameterAction = "Action"
+var windowsUpdateParameterAllowReboot = "AllowReboot"
+var windowsUpdateParameterCategories = "Categories"
+var windowsUpdateParameterSeverityLevels = "SeverityLevels"
+var windowsUpdateParameterIncludeKbs = "IncludeKbs"
+var windowsUpdateParameterExcludeKbs = "ExcludeKbs"
+var windowsUpdateParameterPublishedDaysOld = "PublishedDaysOld"
+
+var windowsUpdateCategories = []string{
+	"Application", "Connectors", "CriticalUpdates", "DefinitionUpdates", "DeveloperKits", "Drivers", "FeaturePacks",
+	"Guidance", "Microsoft", "SecurityUpdates", "ServicePacks", "Tools", "UpdateRollups", "Updates",
+}
+
+var windowsUpdateSeverityLevels = []string{"Critical", "Important", "Low", "Moderate", "Unspecified"}
+
+var windowsUpdateKbRegexp = regexache.MustCompile(`\bKB\d+\b`)
+var windowsUpdateFailedRegexp = regexache.MustCompile(`(?i)\bfail`)
+var windowsUpdateInstalledRegexp = regexache.MustCompile(`(?i)\b(installed|succeeded)\b`)
+
+// Classifies the KBs mentioned in the output lines of the command invocations as installed or failed.
+func parseWindowsUpdateResults(outputs map[string]string) []interface{} {
+	var results []interface{}
+
+	for _, instanceId := range sortedKeys(outputs) {
+		installed := make(map[string]bool)
+		failed := make(map[string]bool)
+
+		for _, line := range strings.Split(outputs[instanceId], "\n") {
+			for _, kb := range windowsUpdateKbRegexp.FindAllString(line, -1) {
+				if windowsUpdateFailedRegexp.MatchString(line) {
+					failed[kb] = true
+				} else if windowsUpdateInstalledRegexp.MatchString(line) {
+					installed[kb] = true
+				}
+			}
+		}
+
+		for kb := range failed {
+			delete(installed, kb)
+		}
+
+		results = append(results, map[string]interface{}{
+			attInstanceId:   instanceId,
+			attInstalledKbs: sortedKeys(installed),
+			attFailedKbs:    sortedKeys(failed),
+		})
+	}
+
+	return results
+}
+
+func getSortedStringSet(d *schema.ResourceData, key string) []string {
+	values := getStringSet(d, key)
+	sort.Strings(values)
+
+	return values
+}
+
+func setWindowsUpdate(d *schema.ResourceData, command ssmtypes.Command) diag.Diagnostics {
+	values := map[string]interface{}{
+		attStatus:        command.Status,
+		attRequestedTime: command.RequestedDateTime.UTC().Format(time.RFC3339),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Installs Windows updates on the target instances.
+// The installed and the failed KBs are parsed from the outputs of the command invocations.
+func resourceWindowsUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	executionTimeout := d.Get(attExecutionTimeout).(int)
+	comment := d.Get(attComment).(string)
+	outputLocation := getOutputLocation(d)
+
+	ssmParameters := map[string][]string{
+		windowsUpdateParameterAction:      {"Install"},
+		windowsUpdateParameterAllowReboot: {documentBool(d.Get(attAllowReboot).(bool))},
+	}
+
+	lists := map[string]string{
+		attCategories:     windowsUpdateParameterCategories,
+		attSeverityLevels: windowsUpdateParameterSeverityLevels,
+		attIncludeKbs:     windowsUpdateParameterIncludeKbs,
+		attExcludeKbs:     windowsUpdateParameterExcludeKbs,
+	}
+
+	for key, parameter := range lists {
+		if values := getSortedStringSet(d, key); len(values) > 0 {
+			ssmParameters[parameter] = []string{strings.Join(values, ",")}
+		}
+	}
+
+	if v, ok := d.GetOk(attPublishedDaysOld); ok {
+		ssmParameters[windowsUpdateParameterPublishedDaysOld] = []string{strconv.Itoa(v.(int))}
+	}
+
+	extendedCtx, cancel := context.WithTimeout(ctx, time.Duration(executionTimeout+60)*time.Second)
+	defer cancel()
+
+	command, err := awsClients.RunCommand(extendedCtx, &ssmDocumentInstallWindowsUpdates, ssmParameters, getTargets(d), &executionTimeout, &comment, outputLocation.s3Bucket, outputLocation.s3KeyPrefix)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*command.CommandId)
+
+	outputs, err := awsClients.listCommandOutputs(ctx, *command.CommandId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attUpdateResults, parseWindowsUpdateResults(outputs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setWindowsUpdate(d, command)
+}
+
+func resourceWindowsUpdateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	awsClients, ok := m.(*AwsClients)
+	if !ok {
+		return diag.Errorf("meta argument should be of type *AwsClients")
+	}
+
+	command, err := awsClients.GetCommand(ctx, d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if command.CommandId == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setWindowsUpdate(d, command)
+}
+
+func resourceWindowsUpdateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceWindowsUpdateCreate(ctx, d, m)
+}
+
+func resourceWindowsUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceWindowsUpdate() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &createTimeout,
+			Read:    &readTimeout,
+			Update:  &updateTimeout,
+			Delete:  &deleteTimeout,
+			Default: &defaultTimeout,
+		},
+		CreateContext: resourceWindowsUpdateCreate,
+		ReadContext:   resourceWindowsUpdateRead,
+		UpdateContext: resourceWindowsUpdateUpdate,
+		DeleteContext: resourceWindowsUpdateDelete,
+		Schema: map[string]*schema.Schema{
+			attCategories: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(windowsUpdateCategories, false),
+				},
+			},
+			attSeverityLevels: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(windowsUpdateSeverityLevels, false),
+				},
+			},
+			attIncludeKbs: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^KB\d+$`), "must be a KB article Id, e.g. KB1234567"),
+				},
+			},
+			attExcludeKbs: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^KB\d+$`), "must be a KB article Id, e.g. KB1234567"),
+				},
+			},
+			attPublishedDaysOld: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			attAllowReboot: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			attTargets: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						attValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			attExecutionTimeout: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  7200,
+			},
+			attComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			attOutputLocation: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						attS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			attStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attRequestedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			attUpdateResults: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						attInstanceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						attInstalledKbs: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						attFailedKbs: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
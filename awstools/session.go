@@ -0,0 +1,190 @@
+package awstools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Session Manager plugin executable handling the session data channel
+var sessionManagerPlugin = "session-manager-plugin"
+
+const localPortWaitTimeout = 60
+
+// Session Manager plugin processes of the sessions opened by the provider
+var sessions = make(map[string]*exec.Cmd)
+var sessionsMutex sync.Mutex
+
+// Returns a free local TCP port.
+func getFreeLocalPort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// Wait until the local port accepts connections
+func waitForLocalPort(ctx context.Context, port int, timeout int) error {
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+
+	for i := 0; i < timeout; i++ {
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	log.Error(ctx, "Local port is not open.")
+
+	return fmt.Errorf("local port %d is not open", port)
+}
+
+// Resolves SSM endpoint of the client, which depends on the partition of the region and on the endpoint settings.
+func (clients AwsClients) getSsmEndpoint(ctx context.Context) (string, error) {
+	options := clients.ssmClient.Options()
+
+	endpoint, err := options.EndpointResolverV2.ResolveEndpoint(ctx, ssm.EndpointParameters{
+		Region:       aws.String(options.Region),
+		UseDualStack: aws.Bool(options.EndpointOptions.UseDualStackEndpoint == aws.DualStackEndpointStateEnabled),
+		UseFIPS:      aws.Bool(options.EndpointOptions.UseFIPSEndpoint == aws.FIPSEndpointStateEnabled),
+		Endpoint:     options.BaseEndpoint,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return endpoint.URI.String(), nil
+}
+
+// Starts SSM session.
+// Runs Session Manager plugin which keeps the session open until the session is terminated or the provider exits.
+func (clients AwsClients) StartSession(ctx context.Context, input *ssm.StartSessionInput) (string, error) {
+	output, err := clients.ssmClient.StartSession(ctx, input)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return "", err
+	}
+
+	sessionId := *output.SessionId
+
+	response, err := json.Marshal(map[string]*string{
+		"SessionId":  output.SessionId,
+		"TokenValue": output.TokenValue,
+		"StreamUrl":  output.StreamUrl,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	request, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	region := clients.ssmClient.Options().Region
+	endpoint, err := clients.getSsmEndpoint(ctx)
+
+	if err != nil {
+		clients.TerminateSession(ctx, sessionId)
+		return "", err
+	}
+
+	// The process must outlive the context of the resource operation.
+	cmd := exec.Command(sessionManagerPlugin, string(response), region, "StartSession", "", string(request), endpoint)
+
+	if err := cmd.Start(); err != nil {
+		log.Error(ctx, err.Error())
+		clients.TerminateSession(ctx, sessionId)
+		return "", fmt.Errorf("failed to run %s: %w", sessionManagerPlugin, err)
+	}
+
+	sessionsMutex.Lock()
+	sessions[sessionId] = cmd
+	sessionsMutex.Unlock()
+
+	go func() {
+		cmd.Wait()
+
+		sessionsMutex.Lock()
+		if sessions[sessionId] == cmd {
+			delete(sessions, sessionId)
+		}
+		sessionsMutex.Unlock()
+	}()
+
+	log.Info(ctx, fmt.Sprintf("Session %s started.", sessionId))
+
+	return sessionId, nil
+}
+
+// Checks whether the session was opened by the running provider.
+func isSessionOpen(sessionId string) bool {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+
+	_, ok := sessions[sessionId]
+
+	return ok
+}
+
+// Stops Session Manager plugin and terminates SSM session.
+func (clients AwsClients) TerminateSession(ctx context.Context, sessionId string) error {
+	sessionsMutex.Lock()
+	cmd, ok := sessions[sessionId]
+	delete(sessions, sessionId)
+	sessionsMutex.Unlock()
+
+	if ok && cmd.Process != nil {
+		cmd.Process.Kill()
+	}
+
+	_, err := clients.ssmClient.TerminateSession(ctx, &ssm.TerminateSessionInput{
+		SessionId: &sessionId,
+	})
+
+	var notFound *ssmtypes.DoesNotExistException
+	if err != nil && !errors.As(err, &notFound) {
+		log.Error(ctx, err.Error())
+		return err
+	}
+
+	log.Info(ctx, fmt.Sprintf("Session %s terminated.", sessionId))
+
+	return nil
+}
+
+// Stops Session Manager plugin of all the sessions opened by the provider.
+func CloseSessions() {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+
+	for sessionId, cmd := range sessions {
+		if cmd.Process != nil {
+			cmd.Process.Kill()
+		}
+		delete(sessions, sessionId)
+	}
+}
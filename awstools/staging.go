@@ -0,0 +1,78 @@
+package awstools
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	log "github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Uploads the content to S3 bucket.
+// Returns S3 service client with the Region of the bucket.
+func (clients AwsClients) uploadObject(ctx context.Context, s3Bucket string, key string, content []byte) (*s3.Client, error) {
+	s3BucketClient, err := clients.getBucketClient(ctx, &s3Bucket)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	_, err = s3BucketClient.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: &s3Bucket,
+		Key:    &key,
+		Body:   bytes.NewReader(content),
+	})
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return s3BucketClient, nil
+}
+
+// Uploads the content to S3 bucket.
+// Returns presigned URL allowing the instances to download the content without S3 permissions.
+func (clients AwsClients) stageObject(ctx context.Context, s3Bucket string, key string, content []byte, expires time.Duration) (string, error) {
+	s3BucketClient, err := clients.uploadObject(ctx, s3Bucket, key, content)
+
+	if err != nil {
+		return "", err
+	}
+
+	request, err := s3.NewPresignClient(s3BucketClient).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s3Bucket,
+		Key:    &key,
+	}, s3.WithPresignExpires(expires))
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return "", err
+	}
+
+	return request.URL, nil
+}
+
+// Deletes the staged content from S3 bucket.
+func (clients AwsClients) deleteObject(ctx context.Context, s3Bucket string, key string) error {
+	s3BucketClient, err := clients.getBucketClient(ctx, &s3Bucket)
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return err
+	}
+
+	_, err = s3BucketClient.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: &s3Bucket,
+		Key:    &key,
+	})
+
+	if err != nil {
+		log.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
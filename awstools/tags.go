@@ -0,0 +1,102 @@
+package awstools
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attribute of the taggable resources
+const attTags string = "tags"
+
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func expandTags(tags map[string]interface{}) []ssmtypes.Tag {
+	var ssmTags []ssmtypes.Tag
+
+	for key, value := range tags {
+		ssmTags = append(ssmTags, ssmtypes.Tag{Key: aws.String(key), Value: aws.String(value.(string))})
+	}
+
+	return ssmTags
+}
+
+func flattenTags(ssmTags []ssmtypes.Tag) map[string]string {
+	tags := make(map[string]string)
+
+	for _, tag := range ssmTags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return tags
+}
+
+// Retrieves the tags of SSM resource.
+func (clients AwsClients) listTags(ctx context.Context, resourceType ssmtypes.ResourceTypeForTagging, resourceId string) (map[string]string, error) {
+	output, err := clients.ssmClient.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   &resourceId,
+		ResourceType: resourceType,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenTags(output.TagList), nil
+}
+
+// Adds the new and changed tags and removes the deleted tags of SSM resource.
+func (clients AwsClients) updateTags(ctx context.Context, resourceType ssmtypes.ResourceTypeForTagging, resourceId string, oldTags map[string]interface{}, newTags map[string]interface{}) error {
+	var removedKeys []string
+
+	for key := range oldTags {
+		if _, ok := newTags[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+
+	if len(removedKeys) > 0 {
+		_, err := clients.ssmClient.RemoveTagsFromResource(ctx, &ssm.RemoveTagsFromResourceInput{
+			ResourceId:   &resourceId,
+			ResourceType: resourceType,
+			TagKeys:      removedKeys,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	changedTags := make(map[string]interface{})
+
+	for key, value := range newTags {
+		if oldValue, ok := oldTags[key]; !ok || oldValue != value {
+			changedTags[key] = value
+		}
+	}
+
+	if len(changedTags) > 0 {
+		_, err := clients.ssmClient.AddTagsToResource(ctx, &ssm.AddTagsToResourceInput{
+			ResourceId:   &resourceId,
+			ResourceType: resourceType,
+			Tags:         expandTags(changedTags),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -13,4 +13,6 @@ func main() {
 			return awstools.Provider()
 		},
 	})
+
+	awstools.CloseSessions()
 }